@@ -16,6 +16,9 @@ package awsemfexporter // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
 
 	"go.uber.org/zap"
 
@@ -60,6 +63,42 @@ type Config struct {
 	// MetricDeclarations is the list of rules to be used to set dimensions for exported metrics.
 	MetricDeclarations []*MetricDeclaration `mapstructure:"metric_declarations"`
 
+	// UnresolvedPatternPlaceholder is the value replacing the patterns of LogGroupName and LogStreamName that cannot be
+	// resolved. Default is "undefined".
+	UnresolvedPatternPlaceholder string `mapstructure:"unresolved_pattern_placeholder"`
+
+	// IncludeMetrics is the list of glob patterns, where "*" matches any sequence of characters, of the names of the
+	// metrics to export. If it is set, metrics that do not match any of the patterns are dropped.
+	IncludeMetrics []string `mapstructure:"include_metrics"`
+
+	// ExcludeMetrics is the list of glob patterns of the names of the metrics to drop. Metrics matching IncludeMetrics
+	// are exported even if they match ExcludeMetrics.
+	ExcludeMetrics []string `mapstructure:"exclude_metrics"`
+
+	// MetricNamePrefix is prepended to the names of the emitted metrics, e.g. "collector1." emits "latency" as
+	// "collector1.latency". MetricDescriptors and DimensionRollups match the metric names without the prefix,
+	// whereas MetricDeclarations and the detection of duplicate metrics see the prefixed names.
+	MetricNamePrefix string `mapstructure:"metric_name_prefix"`
+
+	// IncludeDimensions is the list of labels that are kept as dimensions. All labels are kept if it is empty.
+	// Labels that are not kept neither become dimensions nor affect the grouping of metrics.
+	IncludeDimensions []string `mapstructure:"include_dimensions"`
+
+	// ExcludeDimensions is the list of labels that are not kept as dimensions, even if they are in IncludeDimensions.
+	ExcludeDimensions []string `mapstructure:"exclude_dimensions"`
+
+	// RetainExcludedDimensions is an option to still emit the labels filtered out by IncludeDimensions and
+	// ExcludeDimensions as fields. Their values are taken from the first metric of the group.
+	RetainExcludedDimensions bool `mapstructure:"retain_excluded_dimensions"`
+
+	// NonDimensionLabels is the list of labels that are emitted as fields but neither become dimensions nor affect
+	// the grouping of metrics, e.g. to query them with CloudWatch Logs Insights without paying for their cardinality.
+	// Their values are taken from the first metric of the group.
+	NonDimensionLabels []string `mapstructure:"non_dimension_labels"`
+
+	// DimensionRollups is the list of rules to additionally emit metrics grouped by a reduced set of labels.
+	DimensionRollups []*DimensionRollup `mapstructure:"dimension_rollups"`
+
 	// MetricDescriptors is the list of override metric descriptors that are sent to the CloudWatch
 	MetricDescriptors []MetricDescriptor `mapstructure:"metric_descriptors"`
 
@@ -69,28 +108,145 @@ type Config struct {
 	// TODO: we can support directing output to a file (in the future) while customer specifies a file path here.
 	OutputDestination string `mapstructure:"output_destination"`
 
+	// DryRun is an option to log the EMF logs at info level instead of sending them to the output destination, which
+	// is useful to check the output of the exporter without publishing metrics.
+	DryRun bool `mapstructure:"dry_run"`
+
 	// EKSFargateContainerInsightsEnabled is an option to reformat certin metric labels so that they take the form of a high level object
 	// The end result will make the labels look like those coming out of ECS and be more easily injected into cloudwatch
 	// Note that at the moment in order to use this feature the value "kubernetes" must also be added to the ParseJSONEncodedAttributeValues array in order to be used
+	// Labels prefixed with "k8s.pod.labels." and "k8s.node.labels." are added to the "labels" and "node_labels" objects of the wrapper.
 	EKSFargateContainerInsightsEnabled bool `mapstructure:"eks_fargate_container_insights_enabled"`
 
+	// KubernetesMetadataEnabled is an option to add the "kubernetes" JSON wrapper to metrics whose "Type" label is "Pod",
+	// "Container" or "Node", regardless of the launch type. It is implied by EKSFargateContainerInsightsEnabled.
+	KubernetesMetadataEnabled bool `mapstructure:"kubernetes_metadata_enabled"`
+
+	// KubernetesWrapperDimensions is the list of fields of the "kubernetes" JSON wrapper that are also emitted as dimensions,
+	// e.g. "namespace_name" and "pod_name". It only applies when KubernetesMetadataEnabled or EKSFargateContainerInsightsEnabled is set.
+	// Supported fields are "container_name", "host", "namespace_name", "pod_id", "pod_name" and "service_name".
+	KubernetesWrapperDimensions []string `mapstructure:"kubernetes_wrapper_dimensions"`
+
 	// ResourceToTelemetrySettings is the option for converting resource attrihutes to telemetry attributes.
 	// "Enabled" - A boolean field to enable/disable this option. Default is `false`.
 	// If enabled, all the resource attributes will be converted to metric labels by default.
 	ResourceToTelemetrySettings resourcetotelemetry.Settings `mapstructure:"resource_to_telemetry_conversion"`
 
+	// ExponentialHistogramPercentilesEnabled is an option to emit the p50, p90 and p99 percentiles estimated from
+	// the exponential histogram buckets as separate fields named "<metric name>_p50", "<metric name>_p90" and "<metric name>_p99".
+	ExponentialHistogramPercentilesEnabled bool `mapstructure:"exponential_histogram_percentiles_enabled"`
+
+	// ResourceIdentityAttribute is the name of the resource attribute identifying the source of the metrics, e.g. "host.name".
+	// If set, its value is included when grouping metrics so that metrics from different sources with identical labels are
+	// kept in separate EMF events. It only affects grouping, the value is not emitted as a field or dimension unless it is
+	// also a metric label. Default is "" which groups metrics by their labels only.
+	ResourceIdentityAttribute string `mapstructure:"resource_identity_attribute"`
+
+	// ResourceAttributesAsDimensions is the list of resource attributes, e.g. "service.name", added to the labels of the
+	// metrics so that they are used for grouping and emitted as dimensions. A metric label with the same name as a
+	// resource attribute takes precedence over it. Resource attributes that are not set are skipped.
+	ResourceAttributesAsDimensions []string `mapstructure:"resource_attributes_as_dimensions"`
+
+	// SummaryQuantilesEnabled is an option to emit the quantiles of summaries as separate metrics suffixed with the quantile,
+	// e.g. "<metric name>_p99", and their count and sum as separate fields named "<metric name>_count" and "<metric name>_sum".
+	SummaryQuantilesEnabled bool `mapstructure:"summary_quantiles_enabled"`
+
+	// LabelValueNewlineHandling is the option for handling newline characters in label values that are used as dimensions.
+	LabelValueNewlineHandling LabelValueNewlineHandling `mapstructure:"label_value_newline_handling"`
+
+	// PreserveUCUMUnits is an option to emit the units of metrics as is instead of translating them to CloudWatch units,
+	// e.g. "ms" is kept instead of being translated to "Milliseconds". Units overwritten by MetricDescriptors are still applied.
+	PreserveUCUMUnits bool `mapstructure:"preserve_ucum_units"`
+
+	// ValidateUnits is an option to log a warning when the unit of a metric is incompatible with its data points,
+	// e.g. a counter reported as "Percent" or a "Bytes" gauge with a value below 1.
+	ValidateUnits bool `mapstructure:"validate_units"`
+
+	// EmitTemporality is an option to add a "temporality" field with the source aggregation temporality ("delta" or
+	// "cumulative") to the EMF logs of sum metrics. Sums of different temporalities are put in separate EMF logs.
+	EmitTemporality bool `mapstructure:"emit_temporality"`
+
+	// IncludeScope is an option to add "otel_scope_name" and "otel_scope_version" fields with the name and version of
+	// the instrumentation scope to the EMF logs. Metrics of different scopes are put in separate EMF logs.
+	IncludeScope bool `mapstructure:"include_scope"`
+
+	// EmitUnitAsField is an option to add a "<metric name>.unit" field with the unit of each metric to the EMF logs,
+	// e.g. to query the unit in CloudWatch Logs Insights. Metrics without a unit have no such field.
+	EmitUnitAsField bool `mapstructure:"emit_unit_as_field"`
+
+	// StorageResolution is the storage resolution of the metrics in seconds, 1 for high resolution or 60 for standard
+	// resolution. It is emitted as the "StorageResolution" of the metrics if set and can be overridden per metric by
+	// the MetricDescriptors. Default is 0 which leaves it out, i.e. standard resolution.
+	StorageResolution int64 `mapstructure:"storage_resolution"`
+
+	// DuplicateMetricStrategy is the option for handling a metric whose name already exists in a group of metrics with
+	// the same labels. Three options are available, default option is "drop".
+	// "drop" - Keep the first metric and drop the duplicate with a warning
+	// "overwrite" - Replace the first metric with the duplicate
+	// "aggregate" - Sum the values of the metrics, falling back to "drop" if their units differ
+	// Monotonic sums that only collide because of the labels filtered out by IncludeDimensions, ExcludeDimensions and
+	// NonDimensionLabels are always aggregated.
+	DuplicateMetricStrategy string `mapstructure:"duplicate_metric_strategy"`
+
+	// TimestampStrategy is the option for choosing the timestamp of a group of metrics with the same labels whose data
+	// points have different timestamps. Data points without a timestamp use the time the metrics were received.
+	// Three options are available, default option is "first".
+	// "first" - Keep data points with different timestamps in different groups
+	// "latest" - Group the data points regardless of their timestamps and use the latest one
+	// "earliest" - Group the data points regardless of their timestamps and use the earliest one
+	TimestampStrategy string `mapstructure:"timestamp_strategy"`
+
+	// InvalidValuePolicy is the option for handling data points with NaN or infinite values, which CloudWatch rejects.
+	// Three options are available, default option is "drop".
+	// "drop" - Drop the data point with a debug log
+	// "zero" - Replace the NaN and infinite values with 0
+	// "error" - Fail the export of the metrics with a permanent error
+	InvalidValuePolicy string `mapstructure:"invalid_value_policy"`
+
+	// MaxGroupsPerBatch is the maximum number of groups of metrics with the same labels sent as EMF logs to CloudWatch
+	// before the logs are flushed. A push with more groups is sent as several batches, e.g. to stay within the
+	// CloudWatch limits when the metrics have many distinct label sets. Default is 0 which sends all groups at once.
+	// The batches are sent at least once: if a batch fails, the whole push is retried, including the batches already sent.
+	MaxGroupsPerBatch int `mapstructure:"max_groups_per_batch"`
+
 	// logger is the Logger used for writing error/warning logs
 	logger *zap.Logger
 }
 
 type MetricDescriptor struct {
-	// MetricName is the name of the metric
+	// MetricName is the name of the metric. It may be a pattern containing "*" wildcards, e.g. "http.server.*",
+	// which applies to the metrics matching it that have no descriptor with their exact name.
 	MetricName string `mapstructure:"metric_name"`
 	// Unit defines the override value of metric descriptor `unit`
 	Unit string `mapstructure:"unit"`
 	// Overwrite set to true means the existing metric descriptor will be overwritten or a new metric descriptor will be created; false means
 	// the descriptor will only be configured if empty.
 	Overwrite bool `mapstructure:"overwrite"`
+	// StorageResolution overrides the storage resolution of the metric, 1 for high resolution or 60 for standard resolution.
+	StorageResolution int64 `mapstructure:"storage_resolution"`
+	// Namespace overrides the CloudWatch namespace of the metric, which is then emitted separately from the metrics
+	// of other namespaces.
+	Namespace string `mapstructure:"namespace"`
+	// ScaleFactor is the factor the values of the metric are multiplied by, e.g. 100 to turn a fraction into a percentage.
+	ScaleFactor float64 `mapstructure:"scale_factor"`
+	// Dimensions is the list of dimension sets the metric is emitted under instead of the dimensions of its labels,
+	// e.g. [["service"], ["service", "instance"]]. Sets with labels the metric does not have are skipped. It is ignored
+	// when metric declarations are defined.
+	Dimensions [][]string `mapstructure:"dimensions"`
+}
+
+// LabelValueNewlineHandling defines how newline characters in label values are handled.
+type LabelValueNewlineHandling struct {
+	// Mode is the handling mode for label values containing newline characters. Three options are available, default option is "none".
+	// "none" - Keep the label values as is
+	// "strip" - Remove newline characters from the label values used as dimensions
+	// "replace" - Replace newline characters in the label values used as dimensions with Replacement
+	Mode string `mapstructure:"mode"`
+	// Replacement is the string used to replace newline characters when Mode is "replace".
+	Replacement string `mapstructure:"replacement"`
+	// RetainInFields set to true means label values which are not used as dimensions keep their newline characters;
+	// false means they are handled the same way as the dimension label values.
+	RetainInFields bool `mapstructure:"retain_in_fields"`
 }
 
 // Validate filters out invalid metricDeclarations and metricDescriptors
@@ -106,12 +262,26 @@ func (config *Config) Validate() error {
 	}
 	config.MetricDeclarations = validDeclarations
 
+	for _, rollup := range config.DimensionRollups {
+		if err := rollup.init(); err != nil {
+			return err
+		}
+	}
+
 	var validDescriptors []MetricDescriptor
 	for _, descriptor := range config.MetricDescriptors {
 		if descriptor.MetricName == "" {
 			continue
 		}
-		if _, ok := eMFSupportedUnits[descriptor.Unit]; ok {
+		if err := validateStorageResolution(descriptor.StorageResolution); err != nil {
+			return fmt.Errorf("metric descriptor %q: %w", descriptor.MetricName, err)
+		}
+		if len(descriptor.Dimensions) > 0 {
+			descriptor.Dimensions = normalizeDimensionSets(descriptor.Dimensions, config.logger)
+		}
+		if descriptor.Unit == "" && (descriptor.StorageResolution != 0 || descriptor.Namespace != "" || descriptor.ScaleFactor != 0 || len(descriptor.Dimensions) > 0) {
+			validDescriptors = append(validDescriptors, descriptor)
+		} else if _, ok := eMFSupportedUnits[descriptor.Unit]; ok {
 			validDescriptors = append(validDescriptors, descriptor)
 		} else {
 			config.logger.Warn("Dropped unsupported metric desctriptor.", zap.String("unit", descriptor.Unit))
@@ -119,6 +289,48 @@ func (config *Config) Validate() error {
 	}
 	config.MetricDescriptors = validDescriptors
 
+	if err := validateStorageResolution(config.StorageResolution); err != nil {
+		return err
+	}
+
+	switch config.LabelValueNewlineHandling.Mode {
+	case "", newlineHandlingNone, newlineHandlingStrip:
+	case newlineHandlingReplace:
+		if config.LabelValueNewlineHandling.Replacement == "" {
+			return errors.New("label_value_newline_handling replacement must be set when mode is \"replace\"")
+		}
+	default:
+		return fmt.Errorf("invalid label_value_newline_handling mode %q, must be one of \"none\", \"strip\" or \"replace\"", config.LabelValueNewlineHandling.Mode)
+	}
+
+	switch config.DuplicateMetricStrategy {
+	case "", duplicateMetricStrategyDrop, duplicateMetricStrategyOverwrite, duplicateMetricStrategyAggregate:
+	default:
+		return fmt.Errorf("invalid duplicate_metric_strategy %q, must be one of \"drop\", \"overwrite\" or \"aggregate\"", config.DuplicateMetricStrategy)
+	}
+
+	switch config.TimestampStrategy {
+	case "", timestampStrategyFirst, timestampStrategyLatest, timestampStrategyEarliest:
+	default:
+		return fmt.Errorf("invalid timestamp_strategy %q, must be one of \"first\", \"latest\" or \"earliest\"", config.TimestampStrategy)
+	}
+
+	switch config.InvalidValuePolicy {
+	case "", invalidValuePolicyDrop, invalidValuePolicyZero, invalidValuePolicyError:
+	default:
+		return fmt.Errorf("invalid invalid_value_policy %q, must be one of \"drop\", \"zero\" or \"error\"", config.InvalidValuePolicy)
+	}
+
+	if config.MaxGroupsPerBatch < 0 {
+		return fmt.Errorf("invalid max_groups_per_batch %d, must not be negative", config.MaxGroupsPerBatch)
+	}
+
+	for _, field := range config.KubernetesWrapperDimensions {
+		if _, ok := kubernetesWrapperDimensionLabels[field]; !ok {
+			return fmt.Errorf("invalid kubernetes_wrapper_dimensions field %q, must be one of %s", field, supportedKubernetesWrapperDimensions())
+		}
+	}
+
 	if !isValidRetentionValue(config.LogRetention) {
 		return errors.New("invalid value for retention policy.  Please make sure to use the following values: 0 (Never Expire), 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1827, 2192, 2557, 2922, 3288, or 3653")
 	}
@@ -126,6 +338,17 @@ func (config *Config) Validate() error {
 	return nil
 }
 
+// supportedKubernetesWrapperDimensions returns the sorted, quoted and comma separated list of the supported
+// kubernetes_wrapper_dimensions fields.
+func supportedKubernetesWrapperDimensions() string {
+	fields := make([]string, 0, len(kubernetesWrapperDimensionLabels))
+	for field := range kubernetesWrapperDimensionLabels {
+		fields = append(fields, fmt.Sprintf("%q", field))
+	}
+	sort.Strings(fields)
+	return strings.Join(fields, ", ")
+}
+
 // Added function to check if value is an accepted number of log retention days
 func isValidRetentionValue(input int64) bool {
 	switch input {
@@ -157,6 +380,23 @@ func isValidRetentionValue(input int64) bool {
 	return false
 }
 
+// unresolvedPatternPlaceholder returns the UnresolvedPatternPlaceholder or "undefined" if it is not set.
+func (config *Config) unresolvedPatternPlaceholder() string {
+	if config.UnresolvedPatternPlaceholder == "" {
+		return defaultUnresolvedPatternPlaceholder
+	}
+	return config.UnresolvedPatternPlaceholder
+}
+
+// validateStorageResolution returns an error if the storage resolution is set to a value not supported by CloudWatch.
+func validateStorageResolution(resolution int64) error {
+	switch resolution {
+	case 0, storageResolutionHigh, storageResolutionStandard:
+		return nil
+	}
+	return fmt.Errorf("invalid storage_resolution %d, must be either %d or %d", resolution, storageResolutionHigh, storageResolutionStandard)
+}
+
 func newEMFSupportedUnits() map[string]interface{} {
 	unitIndexer := map[string]interface{}{}
 	for _, unit := range []string{"Seconds", "Microseconds", "Milliseconds", "Bytes", "Kilobytes", "Megabytes",
@@ -148,6 +148,25 @@ func TestConfigValidate(t *testing.T) {
 	}, cfg.MetricDescriptors)
 }
 
+func TestConfigValidateDescriptorDimensions(t *testing.T) {
+	cfg := &Config{
+		AWSSessionSettings: awsutil.AWSSessionSettings{
+			RequestTimeoutSeconds: 30,
+			MaxRetries:            1,
+		},
+		MetricDescriptors: []MetricDescriptor{
+			{MetricName: "requests", Dimensions: [][]string{{"service"}, {"service", "instance", "service"}, {"instance", "service"}}},
+		},
+		logger: zap.NewNop(),
+	}
+	assert.NoError(t, component.ValidateConfig(cfg))
+
+	// A descriptor may only define dimensions, which are deduped and sorted
+	assert.Equal(t, []MetricDescriptor{
+		{MetricName: "requests", Dimensions: [][]string{{"service"}, {"instance", "service"}}},
+	}, cfg.MetricDescriptors)
+}
+
 func TestRetentionValidateCorrect(t *testing.T) {
 	cfg := &Config{
 		AWSSessionSettings: awsutil.AWSSessionSettings{
@@ -177,3 +196,152 @@ func TestRetentionValidateWrong(t *testing.T) {
 	assert.Error(t, wrongcfg.Validate())
 
 }
+
+func TestConfigValidateOptions(t *testing.T) {
+	testCases := []struct {
+		name        string
+		modify      func(cfg *Config)
+		expectedErr string
+	}{
+		{
+			name:   "default",
+			modify: func(cfg *Config) {},
+		},
+		{
+			name: "newline handling none",
+			modify: func(cfg *Config) {
+				cfg.LabelValueNewlineHandling = LabelValueNewlineHandling{Mode: "none"}
+			},
+		},
+		{
+			name: "newline handling strip",
+			modify: func(cfg *Config) {
+				cfg.LabelValueNewlineHandling = LabelValueNewlineHandling{Mode: "strip"}
+			},
+		},
+		{
+			name: "newline handling replace",
+			modify: func(cfg *Config) {
+				cfg.LabelValueNewlineHandling = LabelValueNewlineHandling{Mode: "replace", Replacement: " "}
+			},
+		},
+		{
+			name: "newline handling replace without replacement",
+			modify: func(cfg *Config) {
+				cfg.LabelValueNewlineHandling = LabelValueNewlineHandling{Mode: "replace"}
+			},
+			expectedErr: `label_value_newline_handling replacement must be set when mode is "replace"`,
+		},
+		{
+			name: "newline handling unknown mode",
+			modify: func(cfg *Config) {
+				cfg.LabelValueNewlineHandling = LabelValueNewlineHandling{Mode: "drop"}
+			},
+			expectedErr: `invalid label_value_newline_handling mode "drop", must be one of "none", "strip" or "replace"`,
+		},
+		{
+			name: "duplicate metric strategy aggregate",
+			modify: func(cfg *Config) {
+				cfg.DuplicateMetricStrategy = "aggregate"
+			},
+		},
+		{
+			name: "unknown duplicate metric strategy",
+			modify: func(cfg *Config) {
+				cfg.DuplicateMetricStrategy = "merge"
+			},
+			expectedErr: `invalid duplicate_metric_strategy "merge", must be one of "drop", "overwrite" or "aggregate"`,
+		},
+		{
+			name: "timestamp strategy latest",
+			modify: func(cfg *Config) {
+				cfg.TimestampStrategy = "latest"
+			},
+		},
+		{
+			name: "unknown timestamp strategy",
+			modify: func(cfg *Config) {
+				cfg.TimestampStrategy = "last"
+			},
+			expectedErr: `invalid timestamp_strategy "last", must be one of "first", "latest" or "earliest"`,
+		},
+		{
+			name: "invalid value policy zero",
+			modify: func(cfg *Config) {
+				cfg.InvalidValuePolicy = "zero"
+			},
+		},
+		{
+			name: "unknown invalid value policy",
+			modify: func(cfg *Config) {
+				cfg.InvalidValuePolicy = "skip"
+			},
+			expectedErr: `invalid invalid_value_policy "skip", must be one of "drop", "zero" or "error"`,
+		},
+		{
+			name: "high storage resolution",
+			modify: func(cfg *Config) {
+				cfg.StorageResolution = 1
+				cfg.MetricDescriptors = []MetricDescriptor{{MetricName: "metric_1", StorageResolution: 60}}
+			},
+		},
+		{
+			name: "unsupported storage resolution",
+			modify: func(cfg *Config) {
+				cfg.StorageResolution = 30
+			},
+			expectedErr: "invalid storage_resolution 30, must be either 1 or 60",
+		},
+		{
+			name: "unsupported metric descriptor storage resolution",
+			modify: func(cfg *Config) {
+				cfg.MetricDescriptors = []MetricDescriptor{{MetricName: "metric_1", Unit: "Count", StorageResolution: 5}}
+			},
+			expectedErr: `metric descriptor "metric_1": invalid storage_resolution 5, must be either 1 or 60`,
+		},
+		{
+			name: "max groups per batch",
+			modify: func(cfg *Config) {
+				cfg.MaxGroupsPerBatch = 100
+			},
+		},
+		{
+			name: "negative max groups per batch",
+			modify: func(cfg *Config) {
+				cfg.MaxGroupsPerBatch = -1
+			},
+			expectedErr: "invalid max_groups_per_batch -1, must not be negative",
+		},
+		{
+			name: "supported kubernetes wrapper dimensions",
+			modify: func(cfg *Config) {
+				cfg.KubernetesWrapperDimensions = []string{"namespace_name", "pod_name", "container_name"}
+			},
+		},
+		{
+			name: "unsupported kubernetes wrapper dimension",
+			modify: func(cfg *Config) {
+				cfg.KubernetesWrapperDimensions = []string{"pod_name", "docker"}
+			},
+			expectedErr: `invalid kubernetes_wrapper_dimensions field "docker", must be one of "container_name", "host", "namespace_name", "pod_id", "pod_name", "service_name"`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &Config{
+				AWSSessionSettings: awsutil.AWSSessionSettings{
+					RequestTimeoutSeconds: 30,
+					MaxRetries:            1,
+				},
+				DimensionRollupOption: "ZeroAndSingleDimensionRollup",
+				logger:                zap.NewNop(),
+			}
+			tc.modify(cfg)
+			if tc.expectedErr == "" {
+				assert.NoError(t, cfg.Validate())
+			} else {
+				assert.EqualError(t, cfg.Validate(), tc.expectedErr)
+			}
+		})
+	}
+}
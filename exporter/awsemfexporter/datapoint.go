@@ -15,6 +15,8 @@
 package awsemfexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsemfexporter"
 
 import (
+	"math"
+	"strconv"
 	"time"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -25,8 +27,20 @@ import (
 )
 
 var deltaMetricCalculator = aws.NewFloat64DeltaCalculator()
+var intDeltaMetricCalculator = aws.NewMetricCalculator(calculateIntDelta)
 var summaryMetricCalculator = aws.NewMetricCalculator(calculateSummaryDelta)
 
+// calculateIntDelta calculates the delta of int64 values, which keeps values above 2^53 exact unlike float64 deltas.
+func calculateIntDelta(prev *aws.MetricValue, val interface{}, timestampMs time.Time) (interface{}, bool) {
+	var deltaValue int64
+	if prev != nil {
+		deltaValue = val.(int64) - prev.RawValue.(int64)
+	} else {
+		return deltaValue, false
+	}
+	return deltaValue, true
+}
+
 func calculateSummaryDelta(prev *aws.MetricValue, val interface{}, timestampMs time.Time) (interface{}, bool) {
 	metricEntry := val.(summaryMetricEntry)
 	summaryDelta := metricEntry.sum
@@ -41,16 +55,22 @@ func calculateSummaryDelta(prev *aws.MetricValue, val interface{}, timestampMs t
 	return summaryMetricEntry{summaryDelta, countDelta}, true
 }
 
+// exponentialHistogramPercentiles are the percentiles estimated from exponential histogram buckets
+var exponentialHistogramPercentiles = []float64{50, 90, 99}
+
 // dataPoint represents a processed metric data point
 type dataPoint struct {
 	value       interface{}
 	labels      map[string]string
 	timestampMs int64
+	// percentiles contains the estimated or reported percentiles keyed by their suffix, e.g. "p99"
+	percentiles map[string]float64
 }
 
 // dataPoints is a wrapper interface for:
 //   - pmetric.NumberDataPointSlice
 //   - pmetric.HistogramDataPointSlice
+//   - pmetric.ExponentialHistogramDataPointSlice
 //   - pmetric.SummaryDataPointSlice
 type dataPoints interface {
 	Len() int
@@ -96,6 +116,13 @@ type histogramDataPointSlice struct {
 	pmetric.HistogramDataPointSlice
 }
 
+// exponentialHistogramDataPointSlice is a wrapper for pmetric.ExponentialHistogramDataPointSlice
+type exponentialHistogramDataPointSlice struct {
+	instrumentationLibraryName string
+	percentilesEnabled         bool
+	pmetric.ExponentialHistogramDataPointSlice
+}
+
 // summaryDataPointSlice is a wrapper for pmetric.SummaryDataPointSlice
 type summaryDataPointSlice struct {
 	instrumentationLibraryName string
@@ -114,14 +141,28 @@ func (dps numberDataPointSlice) At(i int) (dataPoint, bool) {
 	labels := createLabels(metric.Attributes(), dps.instrumentationLibraryName)
 	timestampMs := unixNanoToMilliseconds(metric.Timestamp())
 
-	var metricVal float64
-	switch metric.ValueType() {
-	case pmetric.NumberDataPointValueTypeDouble:
-		metricVal = metric.DoubleValue()
-	case pmetric.NumberDataPointValueTypeInt:
-		metricVal = float64(metric.IntValue())
+	// Integer values are kept as int64 as converting them to float64 loses precision above 2^53
+	if metric.ValueType() == pmetric.NumberDataPointValueTypeInt {
+		metricVal := metric.IntValue()
+		if dps.adjustToDelta {
+			deltaVal, retained := intDeltaMetricCalculator.Calculate(dps.metricName, mergeLabels(dps.deltaMetricMetadata, labels),
+				metricVal, metric.Timestamp().AsTime())
+			if !retained {
+				return dataPoint{}, retained
+			}
+			// The metric is assumed to be reset if the previous value is larger than the current one
+			if deltaVal.(int64) >= 0 {
+				metricVal = deltaVal.(int64)
+			}
+		}
+		return dataPoint{
+			value:       metricVal,
+			labels:      labels,
+			timestampMs: timestampMs,
+		}, true
 	}
 
+	metricVal := metric.DoubleValue()
 	retained := true
 	if dps.adjustToDelta {
 		var deltaVal interface{}
@@ -150,6 +191,94 @@ func (dps histogramDataPointSlice) At(i int) (dataPoint, bool) {
 	labels := createLabels(metric.Attributes(), dps.instrumentationLibraryName)
 	timestamp := unixNanoToMilliseconds(metric.Timestamp())
 
+	stats := &cWMetricStats{
+		Count: metric.Count(),
+		Sum:   metric.Sum(),
+		Max:   metric.Max(),
+		Min:   metric.Min(),
+	}
+	// Min and Max are optional, fall back to the closest bucket boundaries if they are not set.
+	if !metric.HasMin() || !metric.HasMax() {
+		if bucketMin, bucketMax, ok := histogramBucketBoundaries(metric); ok {
+			// The bucket boundaries are approximations, never let them contradict a reported Min or Max.
+			if metric.HasMin() {
+				bucketMax = math.Max(bucketMax, metric.Min())
+			}
+			if metric.HasMax() {
+				bucketMin = math.Min(bucketMin, metric.Max())
+			}
+			if !metric.HasMin() {
+				stats.Min = bucketMin
+			}
+			if !metric.HasMax() {
+				stats.Max = bucketMax
+			}
+		}
+	}
+
+	return dataPoint{
+		value:       stats,
+		labels:      labels,
+		timestampMs: timestamp,
+	}, true
+}
+
+// histogramBucketBoundaries returns the closest finite boundaries of the lowest and highest non-empty buckets
+// of the histogram data point. It returns false if the data point has no explicit bounds or no non-empty bucket.
+func histogramBucketBoundaries(dp pmetric.HistogramDataPoint) (float64, float64, bool) {
+	bounds := dp.ExplicitBounds()
+	counts := dp.BucketCounts()
+	if bounds.Len() == 0 || counts.Len() != bounds.Len()+1 {
+		return 0, 0, false
+	}
+
+	first, last := -1, -1
+	for i := 0; i < counts.Len(); i++ {
+		if counts.At(i) == 0 {
+			continue
+		}
+		if first == -1 {
+			first = i
+		}
+		last = i
+	}
+	if first == -1 {
+		return 0, 0, false
+	}
+
+	// The first bucket is unbounded below and the last bucket is unbounded above,
+	// in which case the only finite boundary of the bucket is used.
+	min := bounds.At(first)
+	if first > 0 {
+		min = bounds.At(first - 1)
+	}
+	max := bounds.At(bounds.Len() - 1)
+	if last < bounds.Len() {
+		max = bounds.At(last)
+	}
+	return min, max, true
+}
+
+// At retrieves the ExponentialHistogramDataPoint at the given index and, if enabled, estimates its percentiles.
+func (dps exponentialHistogramDataPointSlice) At(i int) (dataPoint, bool) {
+	metric := dps.ExponentialHistogramDataPointSlice.At(i)
+	labels := createLabels(metric.Attributes(), dps.instrumentationLibraryName)
+	timestamp := unixNanoToMilliseconds(metric.Timestamp())
+
+	var percentiles map[string]float64
+	if dps.percentilesEnabled && metric.Count() > 0 {
+		for _, p := range exponentialHistogramPercentiles {
+			estimate, ok := estimateExponentialHistogramQuantile(metric, p/100)
+			if !ok {
+				continue
+			}
+			if percentiles == nil {
+				percentiles = make(map[string]float64, len(exponentialHistogramPercentiles))
+			}
+			percentiles[percentileSuffix(p/100)] = estimate
+		}
+	}
+
 	return dataPoint{
 		value: &cWMetricStats{
 			Count: metric.Count(),
@@ -159,9 +288,68 @@ func (dps histogramDataPointSlice) At(i int) (dataPoint, bool) {
 		},
 		labels:      labels,
 		timestampMs: timestamp,
+		percentiles: percentiles,
 	}, true
 }
 
+// estimateExponentialHistogramQuantile estimates the q-quantile (0 <= q <= 1) of the exponential histogram data point
+// by locating the bucket holding the target rank and interpolating linearly between the bucket boundaries.
+// The estimate is clamped to the min and max of the data point if they are set. It returns false if no estimate
+// could be made or if the estimate is not a finite number, e.g. when the bucket boundaries overflow float64.
+func estimateExponentialHistogramQuantile(dp pmetric.ExponentialHistogramDataPoint, q float64) (float64, bool) {
+	// The boundaries are computed as 2^(index * 2^-scale) rather than base^index so that coarse scales, whose base
+	// alone already overflows float64, still yield the boundaries of buckets holding representable values.
+	exponent := math.Exp2(-float64(dp.Scale()))
+	rank := q * float64(dp.Count())
+
+	var estimate float64
+	var cumulative float64
+	found := false
+
+	// Negative buckets hold the smallest values, the highest index holding the largest magnitudes.
+	negative := dp.Negative()
+	for i := negative.BucketCounts().Len() - 1; i >= 0 && !found; i-- {
+		count := float64(negative.BucketCounts().At(i))
+		if count == 0 || cumulative+count < rank {
+			cumulative += count
+			continue
+		}
+		index := float64(negative.Offset()) + float64(i)
+		lower, upper := -math.Exp2((index+1)*exponent), -math.Exp2(index*exponent)
+		estimate = lower + (upper-lower)*(rank-cumulative)/count
+		found = true
+	}
+
+	if !found {
+		cumulative += float64(dp.ZeroCount())
+		found = dp.ZeroCount() > 0 && cumulative >= rank
+	}
+
+	positive := dp.Positive()
+	for i := 0; i < positive.BucketCounts().Len() && !found; i++ {
+		count := float64(positive.BucketCounts().At(i))
+		if count == 0 || cumulative+count < rank {
+			cumulative += count
+			continue
+		}
+		index := float64(positive.Offset()) + float64(i)
+		lower, upper := math.Exp2(index*exponent), math.Exp2((index+1)*exponent)
+		estimate = lower + (upper-lower)*(rank-cumulative)/count
+		found = true
+	}
+
+	if dp.HasMin() && estimate < dp.Min() {
+		estimate = dp.Min()
+	}
+	if dp.HasMax() && estimate > dp.Max() {
+		estimate = dp.Max()
+	}
+	if !found || math.IsNaN(estimate) || math.IsInf(estimate, 0) {
+		return 0, false
+	}
+	return estimate, true
+}
+
 // At retrieves the SummaryDataPoint at the given index.
 func (dps summaryDataPointSlice) At(i int) (dataPoint, bool) {
 	metric := dps.SummaryDataPointSlice.At(i)
@@ -187,18 +375,43 @@ func (dps summaryDataPointSlice) At(i int) (dataPoint, bool) {
 		Count: count,
 		Sum:   sum,
 	}
-	if quantileValues := metric.QuantileValues(); quantileValues.Len() > 0 {
-		metricVal.Min = quantileValues.At(0).Value()
-		metricVal.Max = quantileValues.At(quantileValues.Len() - 1).Value()
+	quantileValues := metric.QuantileValues()
+	if quantileValues.Len() > 0 {
+		if min := quantileValues.At(0).Value(); !math.IsNaN(min) {
+			metricVal.Min = min
+		}
+		if max := quantileValues.At(quantileValues.Len() - 1).Value(); !math.IsNaN(max) {
+			metricVal.Max = max
+		}
+	}
+
+	var percentiles map[string]float64
+	for j := 0; j < quantileValues.Len(); j++ {
+		quantile := quantileValues.At(j)
+		// NaN can't be represented in EMF, such quantiles are skipped
+		if math.IsNaN(quantile.Value()) {
+			continue
+		}
+		if percentiles == nil {
+			percentiles = make(map[string]float64, quantileValues.Len())
+		}
+		percentiles[percentileSuffix(quantile.Quantile())] = quantile.Value()
 	}
 
 	return dataPoint{
 		value:       metricVal,
 		labels:      labels,
 		timestampMs: timestampMs,
+		percentiles: percentiles,
 	}, retained
 }
 
+// percentileSuffix returns the field suffix of the q-quantile (0 <= q <= 1), e.g. "p99" for 0.99 and "p99.9" for 0.999.
+func percentileSuffix(q float64) string {
+	// Round to avoid floating point artifacts such as 0.999 * 100 = 99.89999999999999
+	return "p" + strconv.FormatFloat(math.Round(q*100*1e6)/1e6, 'f', -1, 64)
+}
+
 // createLabels converts OTel AttributesMap attributes to a map
 // and optionally adds in the OTel instrumentation library name
 func createLabels(attributes pcommon.Map, instrLibName string) map[string]string {
@@ -249,6 +462,13 @@ func getDataPoints(pmd pmetric.Metric, metadata cWMetricMetadata, logger *zap.Lo
 			metadata.instrumentationLibraryName,
 			metric.DataPoints(),
 		}
+	case pmetric.MetricTypeExponentialHistogram:
+		metric := pmd.ExponentialHistogram()
+		dps = exponentialHistogramDataPointSlice{
+			metadata.instrumentationLibraryName,
+			metadata.exponentialHistogramPercentilesEnabled,
+			metric.DataPoints(),
+		}
 	case pmetric.MetricTypeSummary:
 		metric := pmd.Summary()
 		// For summaries coming from the prometheus receiver, the sum and count are cumulative, whereas for summaries
@@ -15,7 +15,9 @@
 package awsemfexporter
 
 import (
+	"math"
 	"reflect"
+	"sort"
 	"testing"
 	"time"
 
@@ -271,6 +273,7 @@ func generateTestSummary(name string) []*metricspb.Metric {
 
 func setupDataPointCache() {
 	deltaMetricCalculator = aws.NewFloat64DeltaCalculator()
+	intDeltaMetricCalculator = aws.NewMetricCalculator(calculateIntDelta)
 	summaryMetricCalculator = aws.NewMetricCalculator(calculateSummaryDelta)
 }
 
@@ -289,19 +292,25 @@ func TestIntDataPointSliceAt(t *testing.T) {
 			"w/ 1st delta calculation",
 			true,
 			int64(-17),
-			float64(0),
+			int64(0),
 		},
 		{
 			"w/ 2nd delta calculation",
 			true,
 			int64(1),
-			float64(18),
+			int64(18),
+		},
+		{
+			"w/ delta calculation above 2^53",
+			true,
+			int64(1<<53 + 3),
+			int64(1<<53 + 2),
 		},
 		{
 			"w/o delta calculation",
 			false,
 			int64(10),
-			float64(10),
+			int64(10),
 		},
 	}
 
@@ -338,7 +347,7 @@ func TestIntDataPointSliceAt(t *testing.T) {
 			assert.Equal(t, i > 0, retained)
 			if retained {
 				assert.Equal(t, expectedDP.labels, dp.labels)
-				assert.InDelta(t, expectedDP.value.(float64), dp.value.(float64), 0.02)
+				assert.Equal(t, expectedDP.value, dp.value)
 			}
 		})
 	}
@@ -503,6 +512,337 @@ func TestHistogramDataPointSliceAtWithoutMinMax(t *testing.T) {
 	assert.Equal(t, expectedDP, dp)
 }
 
+func TestHistogramDataPointSliceAtWithBucketBoundaries(t *testing.T) {
+	testCases := []struct {
+		testName     string
+		bucketCounts []uint64
+		min          float64
+		max          float64
+		expectedMin  float64
+		expectedMax  float64
+	}{
+		{
+			"inner buckets",
+			[]uint64{0, 1, 2, 0},
+			math.NaN(),
+			math.NaN(),
+			1,
+			3,
+		},
+		{
+			"unbounded first bucket",
+			[]uint64{1, 0, 2, 0},
+			math.NaN(),
+			math.NaN(),
+			1,
+			3,
+		},
+		{
+			"unbounded last bucket",
+			[]uint64{0, 1, 0, 2},
+			math.NaN(),
+			math.NaN(),
+			1,
+			3,
+		},
+		{
+			"single bucket",
+			[]uint64{0, 0, 5, 0},
+			math.NaN(),
+			math.NaN(),
+			2,
+			3,
+		},
+		{
+			"min set",
+			[]uint64{0, 1, 2, 0},
+			1.5,
+			math.NaN(),
+			1.5,
+			3,
+		},
+		{
+			"min set above the bucket max",
+			[]uint64{0, 1, 2, 0},
+			5,
+			math.NaN(),
+			5,
+			5,
+		},
+		{
+			"max set below the bucket min",
+			[]uint64{0, 1, 2, 0},
+			math.NaN(),
+			0.5,
+			0.5,
+			0.5,
+		},
+		{
+			"empty buckets",
+			[]uint64{0, 0, 0, 0},
+			math.NaN(),
+			math.NaN(),
+			0,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			testDPS := pmetric.NewHistogramDataPointSlice()
+			testDP := testDPS.AppendEmpty()
+			testDP.SetCount(uint64(3))
+			testDP.SetSum(5)
+			testDP.ExplicitBounds().FromRaw([]float64{1, 2, 3})
+			testDP.BucketCounts().FromRaw(tc.bucketCounts)
+			// NaN marks an unset min or max.
+			if !math.IsNaN(tc.min) {
+				testDP.SetMin(tc.min)
+			}
+			if !math.IsNaN(tc.max) {
+				testDP.SetMax(tc.max)
+			}
+
+			dps := histogramDataPointSlice{
+				"cloudwatch-otel",
+				testDPS,
+			}
+
+			dp, retained := dps.At(0)
+			assert.True(t, retained)
+			assert.Equal(t, &cWMetricStats{Count: 3, Sum: 5, Min: tc.expectedMin, Max: tc.expectedMax}, dp.value)
+		})
+	}
+}
+
+func TestExponentialHistogramDataPointSliceAt(t *testing.T) {
+	instrLibName := "cloudwatch-otel"
+
+	testDPS := pmetric.NewExponentialHistogramDataPointSlice()
+	testDP := testDPS.AppendEmpty()
+	testDP.SetCount(uint64(4))
+	testDP.SetSum(10)
+	testDP.SetMin(1)
+	testDP.SetMax(4)
+	testDP.SetScale(0)
+	testDP.Positive().SetOffset(-1)
+	testDP.Positive().BucketCounts().FromRaw([]uint64{1, 1, 2})
+	testDP.Attributes().PutStr("label1", "value1")
+
+	dps := exponentialHistogramDataPointSlice{
+		instrLibName,
+		true,
+		testDPS,
+	}
+
+	expectedDP := dataPoint{
+		value: &cWMetricStats{
+			Sum:   10,
+			Count: 4,
+			Min:   1,
+			Max:   4,
+		},
+		labels: map[string]string{
+			oTellibDimensionKey: instrLibName,
+			"label1":            "value1",
+		},
+		percentiles: map[string]float64{
+			"p50": 2,
+			"p90": 3.6,
+			"p99": 3.96,
+		},
+	}
+
+	assert.Equal(t, 1, dps.Len())
+	dp, _ := dps.At(0)
+	assert.Equal(t, expectedDP.value, dp.value)
+	assert.Equal(t, expectedDP.labels, dp.labels)
+	assert.Equal(t, len(expectedDP.percentiles), len(dp.percentiles))
+	for k, v := range expectedDP.percentiles {
+		assert.InDelta(t, v, dp.percentiles[k], 1e-9, k)
+	}
+}
+
+func TestExponentialHistogramDataPointSliceAtWithoutCount(t *testing.T) {
+	testDPS := pmetric.NewExponentialHistogramDataPointSlice()
+	testDPS.AppendEmpty()
+
+	dps := exponentialHistogramDataPointSlice{
+		"cloudwatch-otel",
+		true,
+		testDPS,
+	}
+
+	dp, retained := dps.At(0)
+	assert.True(t, retained)
+	assert.Equal(t, &cWMetricStats{}, dp.value)
+	assert.Nil(t, dp.percentiles)
+}
+
+func TestExponentialHistogramDataPointSliceAtWithPercentilesDisabled(t *testing.T) {
+	testDPS := pmetric.NewExponentialHistogramDataPointSlice()
+	testDP := testDPS.AppendEmpty()
+	testDP.SetCount(4)
+	testDP.SetSum(10)
+	testDP.Positive().SetOffset(-1)
+	testDP.Positive().BucketCounts().FromRaw([]uint64{1, 1, 2})
+
+	dps := exponentialHistogramDataPointSlice{
+		"cloudwatch-otel",
+		false,
+		testDPS,
+	}
+
+	dp, retained := dps.At(0)
+	assert.True(t, retained)
+	assert.Equal(t, &cWMetricStats{Count: 4, Sum: 10}, dp.value)
+	assert.Nil(t, dp.percentiles)
+}
+
+// generateTestExponentialHistogramDataPoint records the given values into an exponential histogram data point with the given scale.
+func generateTestExponentialHistogramDataPoint(scale int32, values []float64) pmetric.ExponentialHistogramDataPoint {
+	dp := pmetric.NewExponentialHistogramDataPoint()
+	dp.SetScale(scale)
+	dp.SetMin(math.Inf(1))
+	dp.SetMax(math.Inf(-1))
+
+	positive := map[int32]uint64{}
+	negative := map[int32]uint64{}
+	minIndex := map[bool]int32{true: math.MaxInt32, false: math.MaxInt32}
+	maxIndex := map[bool]int32{true: math.MinInt32, false: math.MinInt32}
+	for _, v := range values {
+		dp.SetCount(dp.Count() + 1)
+		dp.SetSum(dp.Sum() + v)
+		dp.SetMin(math.Min(dp.Min(), v))
+		dp.SetMax(math.Max(dp.Max(), v))
+		if v == 0 {
+			dp.SetZeroCount(dp.ZeroCount() + 1)
+			continue
+		}
+		isPositive := v > 0
+		index := int32(math.Ceil(math.Log2(math.Abs(v))*math.Exp2(float64(scale)))) - 1
+		if isPositive {
+			positive[index]++
+		} else {
+			negative[index]++
+		}
+		if index < minIndex[isPositive] {
+			minIndex[isPositive] = index
+		}
+		if index > maxIndex[isPositive] {
+			maxIndex[isPositive] = index
+		}
+	}
+
+	for isPositive, indexes := range map[bool]map[int32]uint64{true: positive, false: negative} {
+		if len(indexes) == 0 {
+			continue
+		}
+		buckets := dp.Negative()
+		if isPositive {
+			buckets = dp.Positive()
+		}
+		buckets.SetOffset(minIndex[isPositive])
+		counts := make([]uint64, maxIndex[isPositive]-minIndex[isPositive]+1)
+		for index, count := range indexes {
+			counts[index-minIndex[isPositive]] = count
+		}
+		buckets.BucketCounts().FromRaw(counts)
+	}
+	return dp
+}
+
+func TestEstimateExponentialHistogramQuantile(t *testing.T) {
+	uniform := make([]float64, 1000)
+	for i := range uniform {
+		uniform[i] = float64(i + 1)
+	}
+	symmetric := make([]float64, 0, 2001)
+	for i := -1000; i <= 1000; i++ {
+		symmetric = append(symmetric, float64(i))
+	}
+
+	testCases := []struct {
+		testName  string
+		scale     int32
+		values    []float64
+		expected  map[float64]float64
+		tolerance float64
+	}{
+		{
+			"uniform values with fine scale",
+			5,
+			uniform,
+			map[float64]float64{0.5: 500, 0.9: 900, 0.99: 990},
+			0.01,
+		},
+		{
+			"uniform values with coarse scale",
+			2,
+			uniform,
+			map[float64]float64{0.5: 500, 0.9: 900, 0.99: 990},
+			0.1,
+		},
+		{
+			"negative, zero and positive values",
+			5,
+			symmetric,
+			map[float64]float64{0.1: -800, 0.5: 0, 0.9: 800, 0.99: 980},
+			0.01,
+		},
+		{
+			"single value",
+			3,
+			[]float64{42},
+			map[float64]float64{0.5: 42, 0.9: 42, 0.99: 42},
+			0,
+		},
+		{
+			"single value with coarsest scale",
+			-10,
+			[]float64{1e10},
+			map[float64]float64{0.5: 1e10, 0.9: 1e10, 0.99: 1e10},
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			dp := generateTestExponentialHistogramDataPoint(tc.scale, tc.values)
+			for q, expected := range tc.expected {
+				actual, ok := estimateExponentialHistogramQuantile(dp, q)
+				assert.True(t, ok, "quantile %v", q)
+				if expected == 0 {
+					assert.InDelta(t, expected, actual, 1, "quantile %v", q)
+				} else {
+					assert.InEpsilon(t, expected, actual, tc.tolerance+1e-9, "quantile %v", q)
+				}
+			}
+		})
+	}
+}
+
+func TestEstimateExponentialHistogramQuantileNotFinite(t *testing.T) {
+	// Without min and max, the upper boundary of the bucket at scale -10 overflows float64.
+	dp := pmetric.NewExponentialHistogramDataPoint()
+	dp.SetCount(1)
+	dp.SetScale(-10)
+	dp.Positive().BucketCounts().FromRaw([]uint64{1})
+
+	_, ok := estimateExponentialHistogramQuantile(dp, 0.5)
+	assert.False(t, ok)
+
+	dps := exponentialHistogramDataPointSlice{
+		"cloudwatch-otel",
+		true,
+		pmetric.NewExponentialHistogramDataPointSlice(),
+	}
+	dp.CopyTo(dps.AppendEmpty())
+	point, retained := dps.At(0)
+	assert.True(t, retained)
+	assert.Nil(t, point.percentiles)
+}
+
 func TestSummaryDataPointSliceAt(t *testing.T) {
 	setupDataPointCache()
 
@@ -589,6 +929,77 @@ func TestSummaryDataPointSliceAt(t *testing.T) {
 	}
 }
 
+func TestSummaryDataPointSliceAtWithQuantiles(t *testing.T) {
+	testCases := []struct {
+		testName            string
+		quantiles           map[float64]float64
+		expectedMin         float64
+		expectedMax         float64
+		expectedPercentiles map[string]float64
+	}{
+		{
+			"several quantiles",
+			map[float64]float64{0: 1, 0.5: 2, 0.9: 4, 0.99: 4.5, 0.999: 4.9, 1: 5},
+			1,
+			5,
+			map[string]float64{"p0": 1, "p50": 2, "p90": 4, "p99": 4.5, "p99.9": 4.9, "p100": 5},
+		},
+		{
+			"empty quantiles",
+			map[float64]float64{},
+			0,
+			0,
+			nil,
+		},
+		{
+			"NaN quantiles",
+			map[float64]float64{0: math.NaN(), 0.5: 2, 1: math.NaN()},
+			0,
+			0,
+			map[string]float64{"p50": 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			testDPS := pmetric.NewSummaryDataPointSlice()
+			testDP := testDPS.AppendEmpty()
+			testDP.SetSum(17.3)
+			testDP.SetCount(17)
+			quantiles := make([]float64, 0, len(tc.quantiles))
+			for q := range tc.quantiles {
+				quantiles = append(quantiles, q)
+			}
+			sort.Float64s(quantiles)
+			for _, q := range quantiles {
+				quantileValue := testDP.QuantileValues().AppendEmpty()
+				quantileValue.SetQuantile(q)
+				quantileValue.SetValue(tc.quantiles[q])
+			}
+
+			dps := summaryDataPointSlice{
+				"cloudwatch-otel",
+				deltaMetricMetadata{adjustToDelta: false},
+				testDPS,
+			}
+
+			dp, retained := dps.At(0)
+			assert.True(t, retained)
+			assert.Equal(t, &cWMetricStats{Count: 17, Sum: 17.3, Min: tc.expectedMin, Max: tc.expectedMax}, dp.value)
+			assert.Equal(t, tc.expectedPercentiles, dp.percentiles)
+		})
+	}
+}
+
+func TestPercentileSuffix(t *testing.T) {
+	assert.Equal(t, "p0", percentileSuffix(0))
+	assert.Equal(t, "p50", percentileSuffix(0.5))
+	assert.Equal(t, "p99", percentileSuffix(0.99))
+	assert.Equal(t, "p99.9", percentileSuffix(0.999))
+	assert.Equal(t, "p99.99", percentileSuffix(0.9999))
+	assert.Equal(t, "p100", percentileSuffix(1))
+}
+
 func TestCreateLabels(t *testing.T) {
 	expectedLabels := map[string]string{
 		"a": "A",
@@ -0,0 +1,71 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsemfexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsemfexporter"
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// DimensionRollup characterizes a rule to emit certain incoming metrics a second time, grouped by a reduced
+// set of labels, e.g. to emit an aggregate of the metrics without any dimension.
+type DimensionRollup struct {
+	// MetricNameSelectors is a list of regex strings to be matched against metric names
+	// to determine which metrics are rolled up. All metrics are rolled up if it is empty.
+	MetricNameSelectors []string `mapstructure:"metric_name_selectors"`
+	// Dimensions is the list of labels kept in the rolled up metrics. All labels are dropped if it is empty.
+	Dimensions []string `mapstructure:"dimensions"`
+
+	// metricRegexList is a list of compiled regexes for metric name selectors.
+	metricRegexList []*regexp.Regexp
+}
+
+// init initializes the DimensionRollup struct by compiling its regex strings.
+func (r *DimensionRollup) init() error {
+	r.metricRegexList = make([]*regexp.Regexp, len(r.MetricNameSelectors))
+	for i, selector := range r.MetricNameSelectors {
+		regex, err := regexp.Compile(selector)
+		if err != nil {
+			return fmt.Errorf("invalid dimension rollup metric name selector %q: %w", selector, err)
+		}
+		r.metricRegexList[i] = regex
+	}
+	return nil
+}
+
+// MatchesName returns true if the given metric name matches any of the metric name selectors
+// of the DimensionRollup, or if it has no selector.
+func (r *DimensionRollup) MatchesName(metricName string) bool {
+	if len(r.metricRegexList) == 0 {
+		return true
+	}
+	for _, regex := range r.metricRegexList {
+		if regex.MatchString(metricName) {
+			return true
+		}
+	}
+	return false
+}
+
+// rollupLabels returns the labels kept by the DimensionRollup. It returns false if no label is dropped.
+func (r *DimensionRollup) rollupLabels(labels map[string]string) (map[string]string, bool) {
+	rolledUp := make(map[string]string, len(r.Dimensions))
+	for _, dimension := range r.Dimensions {
+		if value, ok := labels[dimension]; ok {
+			rolledUp[dimension] = value
+		}
+	}
+	return rolledUp, len(rolledUp) < len(labels)
+}
@@ -0,0 +1,87 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsemfexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDimensionRollupInit(t *testing.T) {
+	r := &DimensionRollup{
+		MetricNameSelectors: []string{"^a+$", "["},
+	}
+	assert.EqualError(t, r.init(), "invalid dimension rollup metric name selector \"[\": error parsing regexp: missing closing ]: `[`")
+}
+
+func TestDimensionRollupMatchesName(t *testing.T) {
+	r := &DimensionRollup{
+		MetricNameSelectors: []string{"^a+$", "^b.*$"},
+	}
+	assert.Nil(t, r.init())
+
+	assert.True(t, r.MatchesName("a"))
+	assert.True(t, r.MatchesName("aaaa"))
+	assert.False(t, r.MatchesName("aaab"))
+	assert.True(t, r.MatchesName("ba"))
+	assert.False(t, r.MatchesName("c"))
+
+	all := &DimensionRollup{}
+	assert.Nil(t, all.init())
+	assert.True(t, all.MatchesName("c"))
+}
+
+func TestDimensionRollupLabels(t *testing.T) {
+	labels := map[string]string{
+		"label1": "value1",
+		"label2": "value2",
+	}
+
+	testCases := []struct {
+		testName       string
+		dimensions     []string
+		expectedLabels map[string]string
+		expectedOk     bool
+	}{
+		{
+			"drop all labels",
+			nil,
+			map[string]string{},
+			true,
+		},
+		{
+			"keep a subset of labels",
+			[]string{"label1", "label3"},
+			map[string]string{"label1": "value1"},
+			true,
+		},
+		{
+			"keep all labels",
+			[]string{"label1", "label2"},
+			labels,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			r := &DimensionRollup{Dimensions: tc.dimensions}
+			rolledUp, ok := r.rollupLabels(labels)
+			assert.Equal(t, tc.expectedOk, ok)
+			assert.Equal(t, tc.expectedLabels, rolledUp)
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/google/uuid"
+	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumererror"
@@ -71,6 +72,10 @@ func newEmfPusher(
 	expConfig := config.(*Config)
 	expConfig.logger = logger
 
+	if err := view.Register(MetricViews()...); err != nil {
+		logger.Warn("Failed to register the views of the exporter metrics, they will not be reported", zap.Error(err))
+	}
+
 	// create AWS session
 	awsConfig, session, err := awsutil.GetAWSConfigSession(logger, &awsutil.Conn{}, &expConfig.AWSSessionSettings)
 	if err != nil {
@@ -117,7 +122,7 @@ func newEmfExporter(
 	return resourcetotelemetry.WrapMetricsExporter(config.(*Config).ResourceToTelemetrySettings, exporter), nil
 }
 
-func (emf *emfExporter) pushMetricsData(_ context.Context, md pmetric.Metrics) error {
+func (emf *emfExporter) pushMetricsData(ctx context.Context, md pmetric.Metrics) error {
 	rms := md.ResourceMetrics()
 	labels := map[string]string{}
 	for i := 0; i < rms.Len(); i++ {
@@ -144,12 +149,43 @@ func (emf *emfExporter) pushMetricsData(_ context.Context, md pmetric.Metrics) e
 		}
 	}
 
+	// batchSize is the number of groups added to the pushers since they were last flushed
+	batchSize := 0
+	// flush sends the groups added to the pushers to CloudWatch, they are only counted as emitted once sent
+	flush := func() error {
+		if err := emf.forceFlushPushers(); err != nil {
+			recordCount(ctx, mGroupedMetricsFailed, batchSize)
+			return err
+		}
+		recordCount(ctx, mGroupedMetricsEmitted, batchSize)
+		batchSize = 0
+		return nil
+	}
 	for _, groupedMetric := range groupedMetrics {
 		cWMetric := translateGroupedMetricToCWMetric(groupedMetric, expConfig)
 		putLogEvent := translateCWMetricToEMF(cWMetric, expConfig)
+		if putLogEvent == nil {
+			emf.logger.Warn(
+				"Failed to serialize metrics into an EMF log, dropping them",
+				zap.String("LogGroup", groupedMetric.metadata.logGroup),
+				zap.Any("Labels", groupedMetric.labels),
+			)
+			recordCount(ctx, mGroupedMetricsFailed, 1)
+			continue
+		}
+		if expConfig.DryRun {
+			emf.logger.Info(
+				"Dry run, EMF log not sent",
+				zap.String("LogGroup", groupedMetric.metadata.logGroup),
+				zap.String("LogStream", groupedMetric.metadata.logStream),
+				zap.String("EMF", *putLogEvent.InputLogEvent.Message),
+			)
+			continue
+		}
 		// Currently we only support two options for "OutputDestination".
 		if strings.EqualFold(outputDestination, outputDestinationStdout) {
 			fmt.Println(*putLogEvent.InputLogEvent.Message)
+			recordCount(ctx, mGroupedMetricsEmitted, 1)
 		} else if strings.EqualFold(outputDestination, outputDestinationCloudWatch) {
 			logGroup := groupedMetric.metadata.logGroup
 			logStream := groupedMetric.metadata.logStream
@@ -161,23 +197,26 @@ func (emf *emfExporter) pushMetricsData(_ context.Context, md pmetric.Metrics) e
 			if emfPusher != nil {
 				returnError := emfPusher.AddLogEntry(putLogEvent)
 				if returnError != nil {
+					// The push fails as a whole, including the groups added since the last flush
+					recordCount(ctx, mGroupedMetricsFailed, batchSize+1)
 					return wrapErrorIfBadRequest(returnError)
 				}
+				batchSize++
+				// Send the batch once it reaches the maximum number of groups, the remaining groups are sent in the next
+				// ones. The delivery is at least once: if a later batch fails, the whole push is retried and the batches
+				// already sent are sent again.
+				if expConfig.MaxGroupsPerBatch > 0 && batchSize >= expConfig.MaxGroupsPerBatch {
+					if err := flush(); err != nil {
+						return err
+					}
+				}
 			}
 		}
 	}
 
-	if strings.EqualFold(outputDestination, outputDestinationCloudWatch) {
-		for _, emfPusher := range emf.listPushers() {
-			returnError := emfPusher.ForceFlush()
-			if returnError != nil {
-				// TODO now we only have one logPusher, so it's ok to return after first error occurred
-				err := wrapErrorIfBadRequest(returnError)
-				if err != nil {
-					emf.logger.Error("Error force flushing logs. Skipping to next logPusher.", zap.Error(err))
-				}
-				return err
-			}
+	if !expConfig.DryRun && strings.EqualFold(outputDestination, outputDestinationCloudWatch) {
+		if err := flush(); err != nil {
+			return err
 		}
 	}
 
@@ -186,6 +225,22 @@ func (emf *emfExporter) pushMetricsData(_ context.Context, md pmetric.Metrics) e
 	return nil
 }
 
+// forceFlushPushers sends the log entries added to the pushers to CloudWatch.
+func (emf *emfExporter) forceFlushPushers() error {
+	for _, emfPusher := range emf.listPushers() {
+		returnError := emfPusher.ForceFlush()
+		if returnError != nil {
+			// TODO now we only have one logPusher, so it's ok to return after first error occurred
+			err := wrapErrorIfBadRequest(returnError)
+			if err != nil {
+				emf.logger.Error("Error force flushing logs. Skipping to next logPusher.", zap.Error(err))
+			}
+			return err
+		}
+	}
+	return nil
+}
+
 func (emf *emfExporter) getPusher(logGroup, logStream string) cwlogs.Pusher {
 	emf.pusherMapLock.Lock()
 	defer emf.pusherMapLock.Unlock()
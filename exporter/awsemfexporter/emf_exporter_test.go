@@ -17,8 +17,10 @@ package awsemfexporter
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	commonpb "github.com/census-instrumentation/opencensus-proto/gen-go/agent/common/v1"
@@ -31,6 +33,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest/observer"
@@ -542,6 +546,139 @@ func TestPushMetricsDataWithErr(t *testing.T) {
 	assert.Nil(t, exp.(*emfExporter).Shutdown(ctx))
 }
 
+func TestPushMetricsDataWithDryRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	factory := NewFactory()
+	expCfg := factory.CreateDefaultConfig().(*Config)
+	expCfg.Region = "us-west-2"
+	expCfg.MaxRetries = 0
+	expCfg.Namespace = "test-namespace"
+	expCfg.LogGroupName = "test-logGroupName"
+	expCfg.LogStreamName = "test-logStreamName"
+	expCfg.DryRun = true
+
+	obs, logs := observer.New(zap.InfoLevel)
+	params := exportertest.NewNopCreateSettings()
+	params.Logger = zap.New(obs)
+	exp, err := newEmfPusher(expCfg, params)
+	require.NoError(t, err)
+
+	logPusher := new(mockPusher)
+	streamToPusherMap := map[string]cwlogs.Pusher{"test-logStreamName": logPusher}
+	exp.(*emfExporter).groupStreamToPusherMap = map[string]map[string]cwlogs.Pusher{}
+	exp.(*emfExporter).groupStreamToPusherMap["test-logGroupName"] = streamToPusherMap
+
+	md := pmetric.NewMetrics()
+	metric := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	metric.SetName("queueSize")
+	metric.SetUnit("1")
+	dp := metric.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetIntValue(7)
+	dp.SetTimestamp(pcommon.Timestamp(100 * time.Second))
+	dp.Attributes().PutStr("queue", "orders")
+
+	assert.NoError(t, exp.(*emfExporter).pushMetricsData(ctx, md))
+	logPusher.AssertNotCalled(t, "AddLogEntry", nil)
+	logPusher.AssertNotCalled(t, "ForceFlush", nil)
+
+	dryRunLogs := logs.FilterMessage("Dry run, EMF log not sent").All()
+	require.Equal(t, 1, len(dryRunLogs))
+	fields := dryRunLogs[0].ContextMap()
+	assert.Equal(t, "test-logGroupName", fields["LogGroup"])
+	assert.Equal(t, "test-logStreamName", fields["LogStream"])
+
+	// The logged EMF log is the one that would have been sent
+	groupedMetrics := make(map[interface{}]*groupedMetric)
+	require.NoError(t, newMetricTranslator(*expCfg).translateOTelToGroupedMetric(md.ResourceMetrics().At(0), groupedMetrics, expCfg))
+	require.Equal(t, 1, len(groupedMetrics))
+	for _, group := range groupedMetrics {
+		event := translateCWMetricToEMF(translateGroupedMetricToCWMetric(group, expCfg), expCfg)
+		assert.JSONEq(t, *event.InputLogEvent.Message, fields["EMF"].(string))
+	}
+}
+
+func TestPushMetricsDataWithMaxGroupsPerBatch(t *testing.T) {
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	metric := metrics.AppendEmpty()
+	metric.SetName("queueSize")
+	dps := metric.SetEmptyGauge().DataPoints()
+	// Each queue is a distinct label set and thus a separate group of metrics
+	for i := 0; i < 5; i++ {
+		dp := dps.AppendEmpty()
+		dp.SetIntValue(int64(i))
+		dp.SetTimestamp(pcommon.Timestamp(100 * time.Second))
+		dp.Attributes().PutStr("queue", fmt.Sprintf("queue-%d", i))
+	}
+
+	testCases := []struct {
+		name              string
+		maxGroupsPerBatch int
+		expectedBatches   []int
+	}{
+		{
+			name:            "not set",
+			expectedBatches: []int{5},
+		},
+		{
+			name:              "more groups than the maximum",
+			maxGroupsPerBatch: 2,
+			expectedBatches:   []int{2, 2, 1},
+		},
+		{
+			name:              "groups divisible by the maximum",
+			maxGroupsPerBatch: 5,
+			expectedBatches:   []int{5},
+		},
+		{
+			name:              "fewer groups than the maximum",
+			maxGroupsPerBatch: 10,
+			expectedBatches:   []int{5},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			expCfg := NewFactory().CreateDefaultConfig().(*Config)
+			expCfg.Region = "us-west-2"
+			expCfg.MaxRetries = 0
+			expCfg.LogGroupName = "test-logGroupName"
+			expCfg.LogStreamName = "test-logStreamName"
+			expCfg.MaxGroupsPerBatch = tc.maxGroupsPerBatch
+			exp, err := newEmfPusher(expCfg, exportertest.NewNopCreateSettings())
+			require.NoError(t, err)
+
+			logPusher := new(mockPusher)
+			logPusher.On("AddLogEntry", nil).Return("")
+			logPusher.On("ForceFlush", nil).Return("")
+			exp.(*emfExporter).groupStreamToPusherMap = map[string]map[string]cwlogs.Pusher{
+				"test-logGroupName": {"test-logStreamName": logPusher},
+			}
+
+			require.NoError(t, exp.(*emfExporter).pushMetricsData(context.Background(), md))
+
+			// Count the log entries added before each flush, the last flush has nothing left to send when the
+			// number of groups is a multiple of the maximum
+			var batches []int
+			batchSize := 0
+			for _, call := range logPusher.Calls {
+				switch call.Method {
+				case "AddLogEntry":
+					batchSize++
+				case "ForceFlush":
+					if batchSize > 0 {
+						batches = append(batches, batchSize)
+					}
+					batchSize = 0
+				}
+			}
+			assert.Equal(t, 0, batchSize)
+			assert.Equal(t, tc.expectedBatches, batches)
+		})
+	}
+}
+
 func TestNewExporterWithoutConfig(t *testing.T) {
 	factory := NewFactory()
 	expCfg := factory.CreateDefaultConfig().(*Config)
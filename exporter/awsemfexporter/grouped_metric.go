@@ -15,9 +15,13 @@
 package awsemfexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsemfexporter"
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"math"
 	"strings"
 
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
 
@@ -26,8 +30,10 @@ import (
 
 // groupedMetric defines set of metrics with same namespace, timestamp and labels
 type groupedMetric struct {
-	labels   map[string]string
-	metrics  map[string]*metricInfo
+	labels  map[string]string
+	metrics map[string]*metricInfo
+	// fields contains the labels excluded from the dimensions that are still emitted as fields
+	fields   map[string]string
 	metadata cWMetricMetadata
 }
 
@@ -35,15 +41,44 @@ type groupedMetric struct {
 type metricInfo struct {
 	value interface{}
 	unit  string
+	// storageResolution is the storage resolution of the metric in seconds, 0 if it is not set
+	storageResolution int64
+	// extraFields contains the fields emitted alongside the metric keyed by their suffix to the metric name, e.g. "p99"
+	extraFields map[string]interface{}
+	// dimensions contains the dimension sets defined by the metric descriptor, nil if the metric uses its labels
+	dimensions [][]string
 }
 
 // addToGroupedMetric processes OT metrics and adds them into GroupedMetric buckets
 func addToGroupedMetric(pmd pmetric.Metric, groupedMetrics map[interface{}]*groupedMetric, metadata cWMetricMetadata, patternReplaceSucceeded bool, logger *zap.Logger, descriptor map[string]MetricDescriptor, config *Config) error {
+	if config != nil && !isMetricIncluded(pmd.Name(), config) {
+		return nil
+	}
+
+	// The prefix only applies to the emitted metric names, descriptors and dimension rollups match the raw metric name.
 	metricName := pmd.Name()
+	if config != nil {
+		metricName = config.MetricNamePrefix + metricName
+	}
 	dps := getDataPoints(pmd, metadata, logger)
 	if dps == nil || dps.Len() == 0 {
 		return nil
 	}
+	unit, scale := translateUnit(pmd, descriptor, config != nil && config.PreserveUCUMUnits)
+	if config != nil && config.ValidateUnits {
+		validateUnit(pmd, unit, logger)
+	}
+	resolution := storageResolution(pmd.Name(), descriptor, config)
+	var dimensions [][]string
+	if d, exists := findMetricDescriptor(pmd.Name(), descriptor); exists {
+		dimensions = d.Dimensions
+		if d.Namespace != "" {
+			metadata.namespace = d.Namespace
+		}
+		if d.ScaleFactor != 0 {
+			scale *= d.ScaleFactor
+		}
+	}
 
 	for i := 0; i < dps.Len(); i++ {
 		dp, retained := dps.At(i)
@@ -52,97 +87,430 @@ func addToGroupedMetric(pmd pmetric.Metric, groupedMetrics map[interface{}]*grou
 		}
 
 		labels := dp.labels
+		// Labels take precedence over the resource attributes with the same name
+		for k, v := range metadata.resourceDimensions {
+			if _, exists := labels[k]; !exists {
+				labels[k] = v
+			}
+		}
 
-		if metricType, ok := labels["Type"]; ok {
-			if (metricType == "Pod" || metricType == "Container") && config.EKSFargateContainerInsightsEnabled {
-				addKubernetesWrapper(labels)
+		if config != nil && (config.KubernetesMetadataEnabled || config.EKSFargateContainerInsightsEnabled) {
+			// Metrics without a Type label are not wrapped
+			switch labels["Type"] {
+			case "Pod", "Container", "Node":
+				addKubernetesWrapper(labels, config.KubernetesWrapperDimensions)
 			}
 		}
 
 		// if patterns were found in config file and weren't replaced by resource attributes, replace those patterns with metric labels.
-		// if patterns are provided for a valid key and that key doesn't exist in the resource attributes, it is replaced with `undefined`.
-		if !patternReplaceSucceeded {
-			if strings.Contains(metadata.logGroup, "undefined") {
-				metadata.logGroup, _ = replacePatterns(config.LogGroupName, labels, config.logger)
+		// if patterns are provided for a valid key and that key doesn't exist in the labels either, it is replaced with the placeholder.
+		if config != nil && !patternReplaceSucceeded {
+			if metadata.logGroupUnresolved && len(config.LogGroupName) > 0 {
+				metadata.logGroup, _ = replacePatterns(config.LogGroupName, labels, config.unresolvedPatternPlaceholder(), config.logger)
+			}
+			if metadata.logStreamUnresolved && len(config.LogStreamName) > 0 {
+				metadata.logStream, _ = replacePatterns(config.LogStreamName, labels, config.unresolvedPatternPlaceholder(), config.logger)
 			}
-			if strings.Contains(metadata.logStream, "undefined") {
-				metadata.logStream, _ = replacePatterns(config.LogStreamName, labels, config.logger)
+		}
+
+		if scale != 1 {
+			scaleDataPoint(&dp, scale)
+		}
+
+		// The values are checked after scaling, which can overflow to infinity.
+		if hasInvalidValue(dp) {
+			policy := invalidValuePolicyDrop
+			if config != nil && config.InvalidValuePolicy != "" {
+				policy = config.InvalidValuePolicy
+			}
+			switch policy {
+			case invalidValuePolicyZero:
+				zeroInvalidValues(&dp)
+			case invalidValuePolicyError:
+				return consumererror.NewPermanent(fmt.Errorf("metric %q has a NaN or infinite value", pmd.Name()))
+			default:
+				logger.Debug("Dropped data point with a NaN or infinite value", zap.String("metric", pmd.Name()))
+				continue
 			}
 		}
 
+		var fields map[string]string
+		labelsFiltered := false
+		if config != nil {
+			numLabels := len(labels)
+			labels, fields = filterDimensions(labels, config)
+			labelsFiltered = len(labels) < numLabels
+		}
+
 		metric := &metricInfo{
-			value: dp.value,
-			unit:  translateUnit(pmd, descriptor),
+			value:             dp.value,
+			unit:              unit,
+			storageResolution: resolution,
+			dimensions:        dimensions,
+		}
+		metrics := map[string]*metricInfo{metricName: metric}
+		switch {
+		case pmd.Type() == pmetric.MetricTypeExponentialHistogram:
+			// Percentiles are only estimated when exponential_histogram_percentiles_enabled is set.
+			addPercentileFields(metric, dp.percentiles)
+		case pmd.Type() == pmetric.MetricTypeSummary && config != nil && config.SummaryQuantilesEnabled:
+			addSummaryQuantileMetrics(metrics, metricName, metric, dp.percentiles)
 		}
 
 		if dp.timestampMs > 0 {
 			metadata.timestampMs = dp.timestampMs
 		}
 
-		// Extra params to use when grouping metrics
-		groupKey := groupedMetricKey(metadata.groupedMetricMetadata, labels)
-		if _, ok := groupedMetrics[groupKey]; ok {
-			// if MetricName already exists in metrics map, print warning log
-			if _, ok := groupedMetrics[groupKey].metrics[metricName]; ok {
-				logger.Warn(
-					"Duplicate metric found",
-					zap.String("Name", metricName),
-					zap.Any("Labels", labels),
-				)
-			} else {
-				groupedMetrics[groupKey].metrics[metricName] = metric
+		strategy := duplicateMetricStrategyDrop
+		if config != nil && config.DuplicateMetricStrategy != "" {
+			strategy = config.DuplicateMetricStrategy
+		}
+		if labelsFiltered && pmd.Type() == pmetric.MetricTypeSum && pmd.Sum().IsMonotonic() {
+			// Counters whose labels only differ by the filtered out labels collapse into the same group. Their values are
+			// deltas, either reported as such or converted from cumulative values, of the same total so they are summed
+			// rather than handled as duplicates. Non-monotonic sums keep the configured strategy.
+			strategy = duplicateMetricStrategyAggregate
+		}
+		timestampStrategy := timestampStrategyFirst
+		if config != nil && config.TimestampStrategy != "" {
+			timestampStrategy = config.TimestampStrategy
+		}
+		// The date patterns are resolved for each data point, so data points of different days can go to
+		// different log groups or streams.
+		groupMetadata := metadata
+		groupMetadata.logGroup = replaceDatePatterns(metadata.logGroup, metadata.timestampMs)
+		groupMetadata.logStream = replaceDatePatterns(metadata.logStream, metadata.timestampMs)
+		addToGroup(groupedMetrics, groupMetadata, labels, fields, metrics, strategy, timestampStrategy, logger)
+
+		// Gauges cannot be rolled up as summing the values of different label sets is meaningless.
+		if config == nil || pmd.Type() == pmetric.MetricTypeGauge {
+			continue
+		}
+		for _, rollup := range config.DimensionRollups {
+			if !rollup.MatchesName(pmd.Name()) {
+				continue
 			}
-		} else {
-			groupedMetrics[groupKey] = &groupedMetric{
-				labels:   labels,
-				metrics:  map[string]*metricInfo{(metricName): metric},
-				metadata: metadata,
+			rolledUpLabels, ok := rollup.rollupLabels(labels)
+			if !ok {
+				continue
+			}
+			// Rolled up metrics are aggregated, so they must not share their values with the metrics above. Only the
+			// metric itself is rolled up, its summary quantile metrics and extra fields, e.g. percentiles, cannot be
+			// aggregated and are dropped.
+			rolledUpMetrics := map[string]*metricInfo{
+				metricName: {
+					value:             metric.value,
+					unit:              metric.unit,
+					storageResolution: metric.storageResolution,
+					dimensions:        metric.dimensions,
+				},
 			}
+			addToGroup(groupedMetrics, groupMetadata, rolledUpLabels, nil, rolledUpMetrics, duplicateMetricStrategyAggregate, timestampStrategy, logger)
 		}
 	}
 
 	return nil
 }
 
+// addToGroup adds the metrics into the GroupedMetric bucket of the metadata and labels, resolving the metrics whose
+// name already exists in the bucket according to the duplicate metric strategy. The fields are only set when the
+// bucket is created. With the "first" timestamp strategy, metrics with different timestamps are kept in different
+// buckets, otherwise the timestamp of the bucket is the latest or earliest timestamp of its metrics.
+func addToGroup(groupedMetrics map[interface{}]*groupedMetric, metadata cWMetricMetadata, labels map[string]string, fields map[string]string, metrics map[string]*metricInfo, strategy string, timestampStrategy string, logger *zap.Logger) {
+	// Extra params to use when grouping metrics
+	keyMetadata := metadata.groupedMetricMetadata
+	if timestampStrategy != timestampStrategyFirst {
+		keyMetadata.timestampMs = 0
+	}
+	groupKey := groupedMetricKey(keyMetadata, labels)
+	group, ok := groupedMetrics[groupKey]
+	if !ok {
+		group = &groupedMetric{
+			labels:   labels,
+			metrics:  make(map[string]*metricInfo, len(metrics)),
+			fields:   fields,
+			metadata: metadata,
+		}
+		groupedMetrics[groupKey] = group
+	}
+	switch timestampStrategy {
+	case timestampStrategyLatest:
+		if metadata.timestampMs > group.metadata.timestampMs {
+			group.metadata.timestampMs = metadata.timestampMs
+		}
+	case timestampStrategyEarliest:
+		if metadata.timestampMs < group.metadata.timestampMs {
+			group.metadata.timestampMs = metadata.timestampMs
+		}
+	}
+	for name, info := range metrics {
+		if existing, ok := group.metrics[name]; ok {
+			if !handleDuplicateMetric(group.metrics, name, existing, info, strategy, labels, logger) {
+				recordCount(context.Background(), mDuplicateMetricsDropped, 1)
+			}
+		} else {
+			group.metrics[name] = info
+		}
+	}
+}
+
+// isMetricIncluded returns false if the metric name must not be exported according to the IncludeMetrics and
+// ExcludeMetrics glob patterns of the config. Metrics matching IncludeMetrics are always exported, whereas
+// other metrics are dropped if IncludeMetrics is set or if they match ExcludeMetrics.
+func isMetricIncluded(metricName string, config *Config) bool {
+	for _, pattern := range config.IncludeMetrics {
+		if matchWildcard(pattern, metricName) {
+			return true
+		}
+	}
+	if len(config.IncludeMetrics) > 0 {
+		return false
+	}
+	for _, pattern := range config.ExcludeMetrics {
+		if matchWildcard(pattern, metricName) {
+			return false
+		}
+	}
+	return true
+}
+
+// filterDimensions filters the labels with the IncludeDimensions and ExcludeDimensions of the config so that the
+// filtered out labels neither become dimensions nor affect grouping. The filtered out labels are returned as fields
+// if RetainExcludedDimensions is set. The NonDimensionLabels are always filtered out and returned as fields.
+func filterDimensions(labels map[string]string, config *Config) (map[string]string, map[string]string) {
+	if len(config.IncludeDimensions) == 0 && len(config.ExcludeDimensions) == 0 && len(config.NonDimensionLabels) == 0 {
+		return labels, nil
+	}
+
+	filtered := make(map[string]string, len(labels))
+	var fields map[string]string
+	for k, v := range labels {
+		nonDimension := isNonDimensionLabel(k, config)
+		if !nonDimension && isDimensionIncluded(k, config) {
+			filtered[k] = v
+			continue
+		}
+		if nonDimension || config.RetainExcludedDimensions {
+			if fields == nil {
+				fields = make(map[string]string)
+			}
+			fields[k] = v
+		}
+	}
+	return filtered, fields
+}
+
+// isNonDimensionLabel returns true if the label is in NonDimensionLabels.
+func isNonDimensionLabel(label string, config *Config) bool {
+	for _, nonDimension := range config.NonDimensionLabels {
+		if nonDimension == label {
+			return true
+		}
+	}
+	return false
+}
+
+// isDimensionIncluded returns true if the label is in IncludeDimensions, or IncludeDimensions is empty,
+// and it is not in ExcludeDimensions.
+func isDimensionIncluded(label string, config *Config) bool {
+	included := len(config.IncludeDimensions) == 0
+	for _, dimension := range config.IncludeDimensions {
+		if dimension == label {
+			included = true
+			break
+		}
+	}
+	for _, dimension := range config.ExcludeDimensions {
+		if dimension == label {
+			return false
+		}
+	}
+	return included
+}
+
+// handleDuplicateMetric resolves a metric whose name already exists in the group according to the duplicate metric strategy.
+// It returns false if the duplicate metric was dropped.
+func handleDuplicateMetric(metrics map[string]*metricInfo, name string, existing *metricInfo, duplicate *metricInfo, strategy string, labels map[string]string, logger *zap.Logger) bool {
+	switch strategy {
+	case duplicateMetricStrategyOverwrite:
+		metrics[name] = duplicate
+		return true
+	case duplicateMetricStrategyAggregate:
+		if existing.unit != duplicate.unit {
+			logger.Warn(
+				"Duplicate metric with a different unit found, dropping it",
+				zap.String("Name", name),
+				zap.String("Unit", existing.unit),
+				zap.String("DuplicateUnit", duplicate.unit),
+				zap.Any("Labels", labels),
+			)
+			return false
+		}
+		if value, ok := aggregateMetricValues(existing.value, duplicate.value); ok {
+			existing.value = value
+			return true
+		}
+	}
+	// if MetricName already exists in metrics map, print warning log
+	logger.Warn(
+		"Duplicate metric found",
+		zap.String("Name", name),
+		zap.Any("Labels", labels),
+	)
+	return false
+}
+
+// aggregateMetricValues sums two metric values of the same type. Integer and floating-point values are summed as
+// float64. It returns false if the values cannot be aggregated.
+func aggregateMetricValues(value interface{}, other interface{}) (interface{}, bool) {
+	switch v := value.(type) {
+	case float64:
+		switch o := other.(type) {
+		case float64:
+			return v + o, true
+		case int64:
+			return v + float64(o), true
+		}
+	case int64:
+		switch o := other.(type) {
+		case int64:
+			return v + o, true
+		case float64:
+			return float64(v) + o, true
+		}
+	case *cWMetricStats:
+		if o, ok := other.(*cWMetricStats); ok {
+			if v.Count == 0 {
+				return o, true
+			}
+			if o.Count == 0 {
+				return v, true
+			}
+			return &cWMetricStats{
+				Max:   math.Max(v.Max, o.Max),
+				Min:   math.Min(v.Min, o.Min),
+				Count: v.Count + o.Count,
+				Sum:   v.Sum + o.Sum,
+			}, true
+		}
+	}
+	return nil, false
+}
+
+// storageResolution returns the storage resolution of the metric, which is the one of its metric descriptor if set
+// and the configured one otherwise.
+func storageResolution(metricName string, descriptors map[string]MetricDescriptor, config *Config) int64 {
+	if descriptor, exists := findMetricDescriptor(metricName, descriptors); exists && descriptor.StorageResolution != 0 {
+		return descriptor.StorageResolution
+	}
+	if config == nil {
+		return 0
+	}
+	return config.StorageResolution
+}
+
+// toCWMetricDefinition returns the definition of the metric in the CloudWatch metrics of the EMF log.
+func (info *metricInfo) toCWMetricDefinition(metricName string) map[string]interface{} {
+	definition := map[string]interface{}{
+		"Name": metricName,
+	}
+	if info.unit != "" {
+		definition["Unit"] = info.unit
+	}
+	if info.storageResolution != 0 {
+		definition["StorageResolution"] = info.storageResolution
+	}
+	return definition
+}
+
+// addPercentileFields adds the percentiles as extra fields of the metric.
+func addPercentileFields(metric *metricInfo, percentiles map[string]float64) {
+	if len(percentiles) == 0 {
+		return
+	}
+	metric.extraFields = make(map[string]interface{}, len(percentiles))
+	for suffix, value := range percentiles {
+		metric.extraFields[suffix] = value
+	}
+}
+
+// addSummaryQuantileMetrics adds the quantiles of a summary as separate metrics suffixed with the quantile,
+// e.g. "latency_p99", and its count and sum as extra fields of the metric.
+func addSummaryQuantileMetrics(metrics map[string]*metricInfo, metricName string, metric *metricInfo, quantiles map[string]float64) {
+	for suffix, value := range quantiles {
+		metrics[metricName+"_"+suffix] = &metricInfo{
+			value:             value,
+			unit:              metric.unit,
+			storageResolution: metric.storageResolution,
+			dimensions:        metric.dimensions,
+		}
+	}
+	if stats, ok := metric.value.(*cWMetricStats); ok {
+		metric.extraFields = map[string]interface{}{
+			"count": stats.Count,
+			"sum":   stats.Sum,
+		}
+	}
+}
+
 type kubernetesObj struct {
-	ContainerName string                `json:"container_name,omitempty"`
-	Docker        *internalDockerObj    `json:"docker,omitempty"`
-	Host          string                `json:"host,omitempty"`
-	Labels        *internalLabelsObj    `json:"labels,omitempty"`
-	NamespaceName string                `json:"namespace_name,omitempty"`
-	PodID         string                `json:"pod_id,omitempty"`
-	PodName       string                `json:"pod_name,omitempty"`
-	PodOwners     *internalPodOwnersObj `json:"pod_owners,omitempty"`
-	ServiceName   string                `json:"service_name,omitempty"`
+	ContainerName  string                `json:"container_name,omitempty"`
+	ContainerImage string                `json:"container_image,omitempty"`
+	Docker         *internalDockerObj    `json:"docker,omitempty"`
+	Host           string                `json:"host,omitempty"`
+	Labels         internalLabelsObj     `json:"labels,omitempty"`
+	NamespaceName  string                `json:"namespace_name,omitempty"`
+	NodeLabels     internalLabelsObj     `json:"node_labels,omitempty"`
+	PodID          string                `json:"pod_id,omitempty"`
+	PodName        string                `json:"pod_name,omitempty"`
+	PodOwners      *internalPodOwnersObj `json:"pod_owners,omitempty"`
+	ServiceName    string                `json:"service_name,omitempty"`
 }
 
 type internalDockerObj struct {
 	ContainerID string `json:"container_id,omitempty"`
 }
 
-type internalLabelsObj struct {
-	App             string `json:"app,omitempty"`
-	PodTemplateHash string `json:"pod-template-hash,omitempty"`
-}
+// internalLabelsObj holds the Kubernetes labels of a pod or node keyed by their name.
+type internalLabelsObj map[string]string
 
 type internalPodOwnersObj struct {
 	OwnerKind string `json:"owner_kind,omitempty"`
 	OwnerName string `json:"owner_name,omitempty"`
 }
 
-func addKubernetesWrapper(labels map[string]string) {
+const (
+	// kubernetesPodLabelPrefix is the prefix of the labels holding the Kubernetes labels of the pod, as set by the k8sattributes processor
+	kubernetesPodLabelPrefix = "k8s.pod.labels."
+	// kubernetesNodeLabelPrefix is the prefix of the labels holding the Kubernetes labels of the node
+	kubernetesNodeLabelPrefix = "k8s.node.labels."
+)
+
+// kubernetesWrapperDimensionLabels maps the fields of the kubernetes wrapper that can be emitted as dimensions
+// to the labels they are populated from.
+var kubernetesWrapperDimensionLabels = map[string]string{
+	"container_name": "container",
+	"host":           "NodeName",
+	"namespace_name": "Namespace",
+	"pod_id":         "PodId",
+	"pod_name":       "PodName",
+	"service_name":   "Service",
+}
+
+// addKubernetesWrapper adds the "kubernetes" JSON wrapper built from the labels, and also adds the given
+// wrapper fields as labels so that they are emitted as dimensions. Existing labels are never overwritten.
+func addKubernetesWrapper(labels map[string]string, dimensions []string) {
 	// fill in obj
 	filledInObj := kubernetesObj{
-		ContainerName: mapGetHelper(labels, "container"),
+		ContainerName:  mapGetHelper(labels, "container"),
+		ContainerImage: mapGetHelper(labels, "image"),
 		Docker: &internalDockerObj{
 			ContainerID: mapGetHelper(labels, "container_id"),
 		},
-		Host: mapGetHelper(labels, "NodeName"),
-		Labels: &internalLabelsObj{
-			App:             mapGetHelper(labels, "app"),
-			PodTemplateHash: mapGetHelper(labels, "pod-template-hash"),
-		},
+		Host:          mapGetHelper(labels, "NodeName"),
+		Labels:        prefixedLabels(labels, kubernetesPodLabelPrefix),
 		NamespaceName: mapGetHelper(labels, "Namespace"),
+		NodeLabels:    prefixedLabels(labels, kubernetesNodeLabelPrefix),
 		PodID:         mapGetHelper(labels, "PodId"),
 		PodName:       mapGetHelper(labels, "PodName"),
 		PodOwners: &internalPodOwnersObj{
@@ -151,22 +519,52 @@ func addKubernetesWrapper(labels map[string]string) {
 		},
 		ServiceName: mapGetHelper(labels, "Service"),
 	}
+	// The app and pod-template-hash pod labels may also be reported without prefix
+	for _, name := range []string{"app", "pod-template-hash"} {
+		if value := mapGetHelper(labels, name); value != "" {
+			if filledInObj.Labels == nil {
+				filledInObj.Labels = internalLabelsObj{}
+			}
+			filledInObj.Labels[name] = value
+		}
+	}
 
 	// handle nested empty object
 	if filledInObj.Docker.ContainerID == "" {
 		filledInObj.Docker = nil
 	}
 
-	if filledInObj.Labels.App == "" && filledInObj.Labels.PodTemplateHash == "" {
-		filledInObj.Labels = nil
-	}
-
 	if filledInObj.PodOwners.OwnerKind == "" && filledInObj.PodOwners.OwnerName == "" {
 		filledInObj.PodOwners = nil
 	}
 
 	jsonBytes, _ := json.Marshal(filledInObj)
 	labels["kubernetes"] = string(jsonBytes)
+
+	for _, field := range dimensions {
+		if _, exists := labels[field]; exists {
+			continue
+		}
+		if value := mapGetHelper(labels, kubernetesWrapperDimensionLabels[field]); value != "" {
+			labels[field] = value
+		}
+	}
+}
+
+// prefixedLabels returns the labels whose name starts with the prefix keyed by their name without the prefix,
+// or nil if there is none.
+func prefixedLabels(labels map[string]string, prefix string) internalLabelsObj {
+	var prefixed internalLabelsObj
+	for k, v := range labels {
+		if !strings.HasPrefix(k, prefix) || len(k) == len(prefix) {
+			continue
+		}
+		if prefixed == nil {
+			prefixed = internalLabelsObj{}
+		}
+		prefixed[strings.TrimPrefix(k, prefix)] = v
+	}
+	return prefixed
 }
 
 func mapGetHelper(labels map[string]string, key string) string {
@@ -182,13 +580,19 @@ func groupedMetricKey(metadata groupedMetricMetadata, labels map[string]string)
 	return aws.NewKey(metadata, labels)
 }
 
-func translateUnit(metric pmetric.Metric, descriptor map[string]MetricDescriptor) string {
+// translateUnit translates the unit of the metric to a CloudWatch unit. It also returns the scale the metric values
+// must be multiplied by when CloudWatch has no equivalent unit, e.g. nanoseconds are converted to microseconds.
+// If preserveUCUMUnits is set, the unit of the metric is returned as is unless it is overwritten by a descriptor.
+func translateUnit(metric pmetric.Metric, descriptor map[string]MetricDescriptor, preserveUCUMUnits bool) (string, float64) {
 	unit := metric.Unit()
-	if descriptor, exists := descriptor[metric.Name()]; exists {
+	if descriptor, exists := findMetricDescriptor(metric.Name(), descriptor); exists && descriptor.Unit != "" {
 		if unit == "" || descriptor.Overwrite {
-			return descriptor.Unit
+			return descriptor.Unit, 1
 		}
 	}
+	if preserveUCUMUnits {
+		return unit, 1
+	}
 	switch unit {
 	case "ms":
 		unit = "Milliseconds"
@@ -196,10 +600,189 @@ func translateUnit(metric pmetric.Metric, descriptor map[string]MetricDescriptor
 		unit = "Seconds"
 	case "us":
 		unit = "Microseconds"
+	case "ns":
+		// CloudWatch has no nanoseconds unit
+		return "Microseconds", 1e-3
 	case "By":
 		unit = "Bytes"
 	case "Bi":
 		unit = "Bits"
+	case "By/s":
+		unit = "Bytes/Second"
+	case "bit/s":
+		unit = "Bits/Second"
+	case "{count}":
+		unit = "Count"
+	case "1":
+		unit = "None"
+	}
+	return unit, 1
+}
+
+// validateUnit logs a warning if the unit resolved for the metric is incompatible with its data points, which is
+// usually a misconfiguration of the unit.
+func validateUnit(metric pmetric.Metric, unit string, logger *zap.Logger) {
+	if reason := unitMismatch(metric, unit); reason != "" {
+		logger.Warn("Unit incompatible with metric",
+			zap.String("Name", metric.Name()),
+			zap.String("Unit", unit),
+			zap.String("Reason", reason),
+		)
+	}
+}
+
+// unitMismatch returns the reason why the unit is incompatible with the data points of the metric, or an empty
+// string if it is compatible.
+func unitMismatch(metric pmetric.Metric, unit string) string {
+	var dps pmetric.NumberDataPointSlice
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps = metric.Gauge().DataPoints()
+	case pmetric.MetricTypeSum:
+		if metric.Sum().IsMonotonic() {
+			if unit == "Percent" {
+				return "a counter cannot be a percentage"
+			}
+			if strings.HasSuffix(unit, "/Second") {
+				return "a counter cannot be a rate"
+			}
+		}
+		dps = metric.Sum().DataPoints()
+	default:
+		return ""
+	}
+
+	switch unit {
+	case "Bytes", "Bits", "Count":
+		// Fractions of indivisible units are usually values reported in a larger unit
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			if dp.ValueType() == pmetric.NumberDataPointValueTypeDouble && dp.DoubleValue() != 0 && math.Abs(dp.DoubleValue()) < 1 {
+				return "a value below 1 is a fraction of an indivisible unit"
+			}
+		}
+	}
+	return ""
+}
+
+// findMetricDescriptor returns the descriptor of the metric name. Descriptors whose name exactly matches win over
+// descriptors whose name is a pattern containing "*" wildcards, e.g. "http.server.*". If several patterns match,
+// the most specific one, i.e. the one with the most non-wildcard characters, is returned.
+func findMetricDescriptor(metricName string, descriptors map[string]MetricDescriptor) (MetricDescriptor, bool) {
+	if descriptor, exists := descriptors[metricName]; exists {
+		return descriptor, true
+	}
+
+	var best MetricDescriptor
+	bestPattern := ""
+	bestSpecificity := -1
+	for pattern, descriptor := range descriptors {
+		if !strings.Contains(pattern, "*") || !matchWildcard(pattern, metricName) {
+			continue
+		}
+		specificity := len(pattern) - strings.Count(pattern, "*")
+		// Break ties on the pattern itself so that the result does not depend on the map iteration order
+		if specificity > bestSpecificity || (specificity == bestSpecificity && pattern < bestPattern) {
+			best, bestPattern, bestSpecificity = descriptor, pattern, specificity
+		}
+	}
+	return best, bestSpecificity >= 0
+}
+
+// matchWildcard reports whether the name matches the pattern where "*" matches any sequence of characters.
+func matchWildcard(pattern string, name string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == name
+	}
+	if !strings.HasPrefix(name, parts[0]) {
+		return false
+	}
+	name = name[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		index := strings.Index(name, part)
+		if index < 0 {
+			return false
+		}
+		name = name[index+len(part):]
+	}
+	return strings.HasSuffix(name, last)
+}
+
+// isInvalidFloat returns true if the value is NaN or infinite, which cannot be represented in EMF.
+func isInvalidFloat(v float64) bool {
+	return math.IsNaN(v) || math.IsInf(v, 0)
+}
+
+// hasInvalidValue returns true if the value or a percentile of the data point is NaN or infinite.
+func hasInvalidValue(dp dataPoint) bool {
+	switch v := dp.value.(type) {
+	case float64:
+		if isInvalidFloat(v) {
+			return true
+		}
+	case *cWMetricStats:
+		if isInvalidFloat(v.Max) || isInvalidFloat(v.Min) || isInvalidFloat(v.Sum) {
+			return true
+		}
+	}
+	for _, value := range dp.percentiles {
+		if isInvalidFloat(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// zeroInvalidValues replaces the NaN and infinite values and percentiles of the data point with 0.
+func zeroInvalidValues(dp *dataPoint) {
+	zero := func(v float64) float64 {
+		if isInvalidFloat(v) {
+			return 0
+		}
+		return v
+	}
+	switch v := dp.value.(type) {
+	case float64:
+		dp.value = zero(v)
+	case *cWMetricStats:
+		dp.value = &cWMetricStats{
+			Max:   zero(v.Max),
+			Min:   zero(v.Min),
+			Count: v.Count,
+			Sum:   zero(v.Sum),
+		}
+	}
+	if len(dp.percentiles) > 0 {
+		percentiles := make(map[string]float64, len(dp.percentiles))
+		for suffix, value := range dp.percentiles {
+			percentiles[suffix] = zero(value)
+		}
+		dp.percentiles = percentiles
+	}
+}
+
+// scaleDataPoint multiplies the values and percentiles of the data point by the scale.
+func scaleDataPoint(dp *dataPoint, scale float64) {
+	switch v := dp.value.(type) {
+	case float64:
+		dp.value = v * scale
+	case int64:
+		dp.value = float64(v) * scale
+	case *cWMetricStats:
+		dp.value = &cWMetricStats{
+			Max:   v.Max * scale,
+			Min:   v.Min * scale,
+			Count: v.Count,
+			Sum:   v.Sum * scale,
+		}
+	}
+	if len(dp.percentiles) > 0 {
+		percentiles := make(map[string]float64, len(dp.percentiles))
+		for suffix, value := range dp.percentiles {
+			percentiles[suffix] = value * scale
+		}
+		dp.percentiles = percentiles
 	}
-	return unit
 }
@@ -17,6 +17,8 @@ package awsemfexporter
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"sort"
 	"testing"
 	"time"
 
@@ -25,6 +27,9 @@ import (
 	metricspb "github.com/census-instrumentation/opencensus-proto/gen-go/metrics/v1"
 	resourcepb "github.com/census-instrumentation/opencensus-proto/gen-go/resource/v1"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
 	"go.uber.org/zap"
@@ -55,7 +60,7 @@ func TestAddToGroupedMetric(t *testing.T) {
 			[]*metricspb.Metric{generateTestIntGauge("foo")},
 			map[string]*metricInfo{
 				"foo": {
-					value: float64(1),
+					value: int64(1),
 					unit:  "Count",
 				},
 			},
@@ -77,7 +82,7 @@ func TestAddToGroupedMetric(t *testing.T) {
 			generateTestIntSum("foo"),
 			map[string]*metricInfo{
 				"foo": {
-					value: float64(1),
+					value: int64(1),
 					unit:  "Count",
 				},
 			},
@@ -100,6 +105,7 @@ func TestAddToGroupedMetric(t *testing.T) {
 			map[string]*metricInfo{
 				"foo": {
 					value: &cWMetricStats{
+						Max:   10,
 						Count: 18,
 						Sum:   35.0,
 					},
@@ -347,7 +353,7 @@ func TestAddToGroupedMetric(t *testing.T) {
 			assert.Equal(t, 1, len(group.metrics))
 			expectedMetrics := map[string]*metricInfo{
 				"int-gauge": {
-					value: float64(1),
+					value: int64(1),
 					unit:  "Count",
 				},
 			}
@@ -446,6 +452,202 @@ func TestAddToGroupedMetric(t *testing.T) {
 
 }
 
+func TestAddToGroupedMetricWithHistogram(t *testing.T) {
+	namespace := "namespace"
+	instrumentationLibName := "cloudwatch-otel"
+	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
+
+	testCases := []struct {
+		testName      string
+		setMinMax     bool
+		expectedStats *cWMetricStats
+	}{
+		{
+			"histogram with min and max",
+			true,
+			&cWMetricStats{Count: 6, Sum: 105, Min: 3, Max: 40},
+		},
+		{
+			"histogram without min and max",
+			false,
+			&cWMetricStats{Count: 6, Sum: 105, Min: 0, Max: 50},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			metric := pmetric.NewMetric()
+			metric.SetName("latency")
+			metric.SetUnit("ms")
+			dp := metric.SetEmptyHistogram().DataPoints().AppendEmpty()
+			dp.SetCount(6)
+			dp.SetSum(105)
+			if tc.setMinMax {
+				dp.SetMin(3)
+				dp.SetMax(40)
+			}
+			dp.ExplicitBounds().FromRaw([]float64{0, 10, 50, 100})
+			dp.BucketCounts().FromRaw([]uint64{0, 2, 4, 0, 0})
+			dp.Attributes().PutStr("label1", "value1")
+
+			groupedMetrics := make(map[interface{}]*groupedMetric)
+			metadata := generateTestMetricMetadata(namespace, timestamp, logGroup, logStreamName, instrumentationLibName, metric.Type())
+			err := addToGroupedMetric(metric, groupedMetrics, metadata, true, zap.NewNop(), nil, nil)
+			assert.Nil(t, err)
+
+			assert.Equal(t, 1, len(groupedMetrics))
+			for _, v := range groupedMetrics {
+				assert.Equal(t, 1, len(v.metrics))
+				assert.Equal(t, tc.expectedStats, v.metrics["latency"].value)
+				assert.Equal(t, "Milliseconds", v.metrics["latency"].unit)
+				assert.Equal(t, map[string]string{oTellibDimensionKey: instrumentationLibName, "label1": "value1"}, v.labels)
+				assert.Equal(t, metadata.groupedMetricMetadata, v.metadata.groupedMetricMetadata)
+			}
+		})
+	}
+}
+
+func TestAddToGroupedMetricWithSummaryQuantiles(t *testing.T) {
+	namespace := "namespace"
+	instrumentationLibName := "cloudwatch-otel"
+	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
+
+	metric := pmetric.NewMetric()
+	metric.SetName("latency")
+	metric.SetUnit("ms")
+	dp := metric.SetEmptySummary().DataPoints().AppendEmpty()
+	dp.SetCount(10)
+	dp.SetSum(42)
+	for q, v := range map[float64]float64{0: 1, 0.5: 3, 0.9: math.NaN(), 0.99: 8, 1: 9} {
+		quantileValue := dp.QuantileValues().AppendEmpty()
+		quantileValue.SetQuantile(q)
+		quantileValue.SetValue(v)
+	}
+	dp.QuantileValues().Sort(func(a, b pmetric.SummaryDataPointValueAtQuantile) bool {
+		return a.Quantile() < b.Quantile()
+	})
+	dp.Attributes().PutStr("label1", "value1")
+
+	testCases := []struct {
+		testName            string
+		quantilesEnabled    bool
+		expectedMetrics     map[string]*metricInfo
+		expectedExtraFields map[string]interface{}
+	}{
+		{
+			"quantiles disabled",
+			false,
+			map[string]*metricInfo{
+				"latency": {value: &cWMetricStats{Count: 10, Sum: 42, Min: 1, Max: 9}, unit: "Milliseconds"},
+			},
+			nil,
+		},
+		{
+			"quantiles enabled",
+			true,
+			map[string]*metricInfo{
+				"latency": {
+					value:       &cWMetricStats{Count: 10, Sum: 42, Min: 1, Max: 9},
+					unit:        "Milliseconds",
+					extraFields: map[string]interface{}{"count": uint64(10), "sum": float64(42)},
+				},
+				"latency_p0":   {value: float64(1), unit: "Milliseconds"},
+				"latency_p50":  {value: float64(3), unit: "Milliseconds"},
+				"latency_p99":  {value: float64(8), unit: "Milliseconds"},
+				"latency_p100": {value: float64(9), unit: "Milliseconds"},
+			},
+			map[string]interface{}{"count": uint64(10), "sum": float64(42)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			groupedMetrics := make(map[interface{}]*groupedMetric)
+			config := &Config{
+				SummaryQuantilesEnabled: tc.quantilesEnabled,
+				logger:                  zap.NewNop(),
+			}
+			metadata := generateTestMetricMetadata(namespace, timestamp, logGroup, logStreamName, instrumentationLibName, metric.Type())
+			// Keep the summary count and sum as reported instead of converting them to deltas
+			metadata.receiver = ""
+			err := addToGroupedMetric(metric, groupedMetrics, metadata, true, zap.NewNop(), nil, config)
+			assert.Nil(t, err)
+
+			assert.Equal(t, 1, len(groupedMetrics))
+			for _, v := range groupedMetrics {
+				assert.Equal(t, tc.expectedMetrics, v.metrics)
+
+				cWMetric := translateGroupedMetricToCWMetric(v, config)
+				assert.Equal(t, len(tc.expectedMetrics), len(cWMetric.measurements[0].Metrics))
+				for suffix, value := range tc.expectedExtraFields {
+					assert.Equal(t, value, cWMetric.fields["latency_"+suffix])
+				}
+			}
+		})
+	}
+}
+
+func TestAddToGroupedMetricWithExponentialHistogramPercentiles(t *testing.T) {
+	namespace := "namespace"
+	instrumentationLibName := "cloudwatch-otel"
+	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
+
+	metric := pmetric.NewMetric()
+	metric.SetName("foo")
+	metric.SetUnit("ms")
+	dp := metric.SetEmptyExponentialHistogram().DataPoints().AppendEmpty()
+	dp.SetCount(4)
+	dp.SetSum(10)
+	dp.SetMin(1)
+	dp.SetMax(4)
+	dp.Positive().SetOffset(-1)
+	dp.Positive().BucketCounts().FromRaw([]uint64{1, 1, 2})
+	dp.Attributes().PutStr("label1", "value1")
+
+	testCases := []struct {
+		testName            string
+		percentilesEnabled  bool
+		expectedPercentiles map[string]float64
+	}{
+		{
+			"percentiles disabled",
+			false,
+			nil,
+		},
+		{
+			"percentiles enabled",
+			true,
+			map[string]float64{"p50": 2, "p90": 3.6, "p99": 3.96},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			groupedMetrics := make(map[interface{}]*groupedMetric)
+			config := &Config{
+				ExponentialHistogramPercentilesEnabled: tc.percentilesEnabled,
+				logger:                                 zap.NewNop(),
+			}
+			metadata := generateTestMetricMetadata(namespace, timestamp, logGroup, logStreamName, instrumentationLibName, metric.Type())
+			metadata.exponentialHistogramPercentilesEnabled = config.ExponentialHistogramPercentilesEnabled
+			err := addToGroupedMetric(metric, groupedMetrics, metadata, true, zap.NewNop(), nil, config)
+			assert.Nil(t, err)
+
+			assert.Equal(t, 1, len(groupedMetrics))
+			for _, v := range groupedMetrics {
+				assert.Equal(t, 1, len(v.metrics))
+				info := v.metrics["foo"]
+				assert.Equal(t, &cWMetricStats{Count: 4, Sum: 10, Min: 1, Max: 4}, info.value)
+				assert.Equal(t, "Milliseconds", info.unit)
+				assert.Equal(t, len(tc.expectedPercentiles), len(info.extraFields))
+				for k, expected := range tc.expectedPercentiles {
+					assert.InDelta(t, expected, info.extraFields[k], 1e-9, k)
+				}
+			}
+		})
+	}
+}
+
 func TestAddKubernetesWrapper(t *testing.T) {
 	t.Run("Test basic creation", func(t *testing.T) {
 		dockerObj := struct {
@@ -465,16 +667,937 @@ func TestAddKubernetesWrapper(t *testing.T) {
 			PodID:         "Le id de Pod",
 		}
 
-		inputs := make(map[string]string)
-		inputs["container_id"] = "Container mccontainter the third"
-		inputs["container"] = "container mccontainer"
-		inputs["NodeName"] = "hosty de la host"
-		inputs["PodId"] = "Le id de Pod"
+		inputs := make(map[string]string)
+		inputs["container_id"] = "Container mccontainter the third"
+		inputs["container"] = "container mccontainer"
+		inputs["NodeName"] = "hosty de la host"
+		inputs["PodId"] = "Le id de Pod"
+
+		jsonBytes, _ := json.Marshal(expectedCreatedObj)
+		addKubernetesWrapper(inputs, nil)
+		assert.Equal(t, string(jsonBytes), inputs["kubernetes"], "The created and expected objects should be the same")
+	})
+
+	t.Run("Test wrapper fields as dimensions", func(t *testing.T) {
+		inputs := map[string]string{
+			"Namespace": "kube-system",
+			"PodName":   "coredns",
+			"NodeName":  "fargate-node",
+		}
+
+		addKubernetesWrapper(inputs, []string{"namespace_name", "pod_name", "service_name"})
+		assert.Equal(t, `{"host":"fargate-node","namespace_name":"kube-system","pod_name":"coredns"}`, inputs["kubernetes"])
+		assert.Equal(t, "kube-system", inputs["namespace_name"])
+		assert.Equal(t, "coredns", inputs["pod_name"])
+		// Fields without a value are not added
+		assert.NotContains(t, inputs, "service_name")
+		assert.NotContains(t, inputs, "host")
+	})
+
+	t.Run("Test pod and node labels and container image", func(t *testing.T) {
+		inputs := map[string]string{
+			"container":                        "coredns",
+			"image":                            "coredns:1.8.7",
+			"app":                              "dns",
+			"k8s.pod.labels.k8s-app":           "kube-dns",
+			"k8s.pod.labels.eks.amazonaws.com": "coredns",
+			"k8s.node.labels.topology.zone":    "us-west-2a",
+			"k8s.pod.labels.":                  "ignored",
+		}
+
+		addKubernetesWrapper(inputs, nil)
+		assert.Equal(t, `{"container_name":"coredns","container_image":"coredns:1.8.7",`+
+			`"labels":{"app":"dns","eks.amazonaws.com":"coredns","k8s-app":"kube-dns"},`+
+			`"node_labels":{"topology.zone":"us-west-2a"}}`, inputs["kubernetes"])
+	})
+
+	t.Run("Test empty labels are collapsed", func(t *testing.T) {
+		inputs := map[string]string{
+			"container": "coredns",
+		}
+
+		addKubernetesWrapper(inputs, nil)
+		assert.Equal(t, `{"container_name":"coredns"}`, inputs["kubernetes"])
+	})
+
+	t.Run("Test wrapper fields do not overwrite existing labels", func(t *testing.T) {
+		inputs := map[string]string{
+			"Namespace":      "kube-system",
+			"PodName":        "coredns",
+			"namespace_name": "default",
+		}
+
+		addKubernetesWrapper(inputs, []string{"namespace_name", "pod_name"})
+		assert.Equal(t, "default", inputs["namespace_name"])
+		assert.Equal(t, "coredns", inputs["pod_name"])
+	})
+}
+
+func TestAddToGroupedMetricWithKubernetesWrapperDimensions(t *testing.T) {
+	metric := pmetric.NewMetric()
+	metric.SetName("pod_cpu_utilization")
+	dp := metric.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetDoubleValue(0.5)
+	dp.Attributes().PutStr("Type", "Pod")
+	dp.Attributes().PutStr("Namespace", "kube-system")
+	dp.Attributes().PutStr("PodName", "coredns")
+
+	config := &Config{
+		DimensionRollupOption:              "NoDimensionRollup",
+		EKSFargateContainerInsightsEnabled: true,
+		KubernetesWrapperDimensions:        []string{"namespace_name", "pod_name"},
+		logger:                             zap.NewNop(),
+	}
+	groupedMetrics := make(map[interface{}]*groupedMetric)
+	metadata := generateTestMetricMetadata("namespace", time.Now().UnixNano()/int64(time.Millisecond), logGroup, logStreamName, "cloudwatch-otel", metric.Type())
+	err := addToGroupedMetric(metric, groupedMetrics, metadata, true, zap.NewNop(), nil, config)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(groupedMetrics))
+
+	for _, group := range groupedMetrics {
+		cWMetric := translateGroupedMetricToCWMetric(group, config)
+		assert.Equal(t, 1, len(cWMetric.measurements))
+		assert.Equal(t, 1, len(cWMetric.measurements[0].Dimensions))
+		assert.Subset(t, cWMetric.measurements[0].Dimensions[0], []string{"namespace_name", "pod_name"})
+		assert.Equal(t, "kube-system", cWMetric.fields["namespace_name"])
+		assert.Equal(t, "coredns", cWMetric.fields["pod_name"])
+		assert.Equal(t, `{"namespace_name":"kube-system","pod_name":"coredns"}`, cWMetric.fields["kubernetes"])
+	}
+}
+
+func TestAddToGroupedMetricWithDuplicateMetricStrategy(t *testing.T) {
+	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
+
+	generateGauge := func(unit string, values ...float64) pmetric.Metric {
+		metric := pmetric.NewMetric()
+		metric.SetName("foo")
+		metric.SetUnit(unit)
+		dps := metric.SetEmptyGauge().DataPoints()
+		for _, value := range values {
+			dp := dps.AppendEmpty()
+			dp.SetDoubleValue(value)
+			dp.Attributes().PutStr("label1", "value1")
+		}
+		return metric
+	}
+	generateHistogram := func(count uint64, sum, min, max float64) pmetric.Metric {
+		metric := pmetric.NewMetric()
+		metric.SetName("foo")
+		metric.SetUnit("ms")
+		dp := metric.SetEmptyHistogram().DataPoints().AppendEmpty()
+		dp.SetCount(count)
+		dp.SetSum(sum)
+		dp.SetMin(min)
+		dp.SetMax(max)
+		dp.Attributes().PutStr("label1", "value1")
+		return metric
+	}
+
+	testCases := []struct {
+		testName      string
+		strategy      string
+		metrics       []pmetric.Metric
+		expectedValue interface{}
+		expectedUnit  string
+	}{
+		{
+			"default drops the duplicate",
+			"",
+			[]pmetric.Metric{generateGauge("ms", 1, 2)},
+			float64(1),
+			"Milliseconds",
+		},
+		{
+			"drop",
+			duplicateMetricStrategyDrop,
+			[]pmetric.Metric{generateGauge("ms", 1, 2)},
+			float64(1),
+			"Milliseconds",
+		},
+		{
+			"overwrite",
+			duplicateMetricStrategyOverwrite,
+			[]pmetric.Metric{generateGauge("ms", 1, 2)},
+			float64(2),
+			"Milliseconds",
+		},
+		{
+			"aggregate",
+			duplicateMetricStrategyAggregate,
+			[]pmetric.Metric{generateGauge("ms", 1, 2, 3)},
+			float64(6),
+			"Milliseconds",
+		},
+		{
+			"aggregate with different units",
+			duplicateMetricStrategyAggregate,
+			[]pmetric.Metric{generateGauge("ms", 1), generateGauge("s", 2)},
+			float64(1),
+			"Milliseconds",
+		},
+		{
+			"aggregate histograms",
+			duplicateMetricStrategyAggregate,
+			[]pmetric.Metric{generateHistogram(2, 10, 3, 7), generateHistogram(3, 6, 1, 4)},
+			&cWMetricStats{Count: 5, Sum: 16, Min: 1, Max: 7},
+			"Milliseconds",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			config := &Config{
+				DuplicateMetricStrategy: tc.strategy,
+				logger:                  zap.NewNop(),
+			}
+			groupedMetrics := make(map[interface{}]*groupedMetric)
+			for _, metric := range tc.metrics {
+				metadata := generateTestMetricMetadata("namespace", timestamp, logGroup, logStreamName, "cloudwatch-otel", metric.Type())
+				err := addToGroupedMetric(metric, groupedMetrics, metadata, true, zap.NewNop(), nil, config)
+				assert.Nil(t, err)
+			}
+
+			assert.Equal(t, 1, len(groupedMetrics))
+			for _, group := range groupedMetrics {
+				assert.Equal(t, map[string]*metricInfo{
+					"foo": {
+						value: tc.expectedValue,
+						unit:  tc.expectedUnit,
+					},
+				}, group.metrics)
+			}
+		})
+	}
+}
+
+func TestAddToGroupedMetricWithDimensionRollups(t *testing.T) {
+	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
+
+	metric := pmetric.NewMetric()
+	metric.SetName("requests")
+	metric.SetUnit("{count}")
+	metric.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	dps := metric.Sum().DataPoints()
+	for i, path := range []string{"/a", "/b", "/c"} {
+		dp := dps.AppendEmpty()
+		dp.SetDoubleValue(float64(i + 1))
+		dp.Attributes().PutStr("service", "frontend")
+		dp.Attributes().PutStr("path", path)
+	}
+
+	other := pmetric.NewMetric()
+	other.SetName("errors")
+	other.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	dp := other.Sum().DataPoints().AppendEmpty()
+	dp.SetDoubleValue(1)
+	dp.Attributes().PutStr("service", "frontend")
+	dp.Attributes().PutStr("path", "/a")
+
+	config := &Config{
+		DimensionRollups: []*DimensionRollup{
+			{MetricNameSelectors: []string{"^requests$"}},
+			{MetricNameSelectors: []string{"^requests$"}, Dimensions: []string{"service"}},
+		},
+		logger: zap.NewNop(),
+	}
+	assert.Nil(t, config.Validate())
+
+	groupedMetrics := make(map[interface{}]*groupedMetric)
+	for _, m := range []pmetric.Metric{metric, other} {
+		metadata := generateTestMetricMetadata("namespace", timestamp, logGroup, logStreamName, "cloudwatch-otel", m.Type())
+		err := addToGroupedMetric(m, groupedMetrics, metadata, true, zap.NewNop(), nil, config)
+		assert.Nil(t, err)
+	}
+
+	// 3 groups with all labels, 1 group without labels and 1 group with the service label only
+	assert.Equal(t, 5, len(groupedMetrics))
+	for _, group := range groupedMetrics {
+		switch len(group.labels) {
+		case 0:
+			assert.Equal(t, map[string]*metricInfo{"requests": {value: float64(6), unit: "Count"}}, group.metrics)
+		case 1:
+			assert.Equal(t, map[string]string{"service": "frontend"}, group.labels)
+			assert.Equal(t, map[string]*metricInfo{"requests": {value: float64(6), unit: "Count"}}, group.metrics)
+		default:
+			assert.Equal(t, "frontend", group.labels["service"])
+			assert.Contains(t, group.metrics, "requests")
+			if group.labels["path"] == "/a" {
+				assert.Equal(t, float64(1), group.metrics["requests"].value)
+				assert.Contains(t, group.metrics, "errors")
+			} else {
+				assert.Equal(t, 1, len(group.metrics))
+			}
+		}
+	}
+}
+
+func TestAddToGroupedMetricWithDimensionRollupsOfSummariesAndGauges(t *testing.T) {
+	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
+
+	summary := pmetric.NewMetric()
+	summary.SetName("latency")
+	summary.SetUnit("ms")
+	summaryDps := summary.SetEmptySummary().DataPoints()
+	for i, path := range []string{"/a", "/b"} {
+		dp := summaryDps.AppendEmpty()
+		dp.SetCount(uint64(10 * (i + 1)))
+		dp.SetSum(float64(100 * (i + 1)))
+		for _, q := range []float64{0, 0.5, 1} {
+			quantileValue := dp.QuantileValues().AppendEmpty()
+			quantileValue.SetQuantile(q)
+			quantileValue.SetValue(float64(i+1) + q*float64(9*(i+1)))
+		}
+		dp.Attributes().PutStr("path", path)
+	}
+
+	gauge := pmetric.NewMetric()
+	gauge.SetName("queue_size")
+	gaugeDps := gauge.SetEmptyGauge().DataPoints()
+	for i, path := range []string{"/a", "/b"} {
+		dp := gaugeDps.AppendEmpty()
+		dp.SetDoubleValue(float64(i + 1))
+		dp.Attributes().PutStr("path", path)
+	}
+
+	config := &Config{
+		SummaryQuantilesEnabled: true,
+		DimensionRollups:        []*DimensionRollup{{}},
+		logger:                  zap.NewNop(),
+	}
+	assert.Nil(t, config.Validate())
+
+	groupedMetrics := make(map[interface{}]*groupedMetric)
+	for _, m := range []pmetric.Metric{summary, gauge} {
+		metadata := generateTestMetricMetadata("namespace", timestamp, logGroup, logStreamName, "cloudwatch-otel", m.Type())
+		// Keep the summary count and sum as reported instead of converting them to deltas
+		metadata.receiver = ""
+		err := addToGroupedMetric(m, groupedMetrics, metadata, true, zap.NewNop(), nil, config)
+		assert.Nil(t, err)
+	}
+
+	// 2 summary and 2 gauge groups with all labels, and only the summary is rolled up
+	assert.Equal(t, 5, len(groupedMetrics))
+	rolledUp := 0
+	for _, group := range groupedMetrics {
+		if len(group.labels) > 0 {
+			continue
+		}
+		rolledUp++
+		// The quantiles are not summed, only the count and sum of the summary are
+		assert.Equal(t, map[string]*metricInfo{
+			"latency": {value: &cWMetricStats{Count: 30, Sum: 300, Min: 1, Max: 20}, unit: "Milliseconds"},
+		}, group.metrics)
+	}
+	assert.Equal(t, 1, rolledUp)
+}
+
+func TestAddToGroupedMetricWithDimensionFilters(t *testing.T) {
+	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
+
+	metric := pmetric.NewMetric()
+	metric.SetName("requests")
+	dps := metric.SetEmptyGauge().DataPoints()
+	for _, podID := range []string{"pod-1", "pod-2"} {
+		dp := dps.AppendEmpty()
+		dp.SetDoubleValue(1)
+		dp.Attributes().PutStr("service", "frontend")
+		dp.Attributes().PutStr("namespace", "default")
+		dp.Attributes().PutStr("pod_id", podID)
+	}
+
+	testCases := []struct {
+		testName          string
+		includeDimensions []string
+		excludeDimensions []string
+		retain            bool
+		expectedLabels    []map[string]string
+		expectedFields    map[string]string
+	}{
+		{
+			"no filters",
+			nil,
+			nil,
+			false,
+			[]map[string]string{
+				{"service": "frontend", "namespace": "default", "pod_id": "pod-1"},
+				{"service": "frontend", "namespace": "default", "pod_id": "pod-2"},
+			},
+			nil,
+		},
+		{
+			"include only",
+			[]string{"service"},
+			nil,
+			false,
+			[]map[string]string{{"service": "frontend"}},
+			nil,
+		},
+		{
+			"exclude only",
+			nil,
+			[]string{"pod_id"},
+			false,
+			[]map[string]string{{"service": "frontend", "namespace": "default"}},
+			nil,
+		},
+		{
+			"exclude wins over include",
+			[]string{"service", "pod_id"},
+			[]string{"pod_id"},
+			false,
+			[]map[string]string{{"service": "frontend"}},
+			nil,
+		},
+		{
+			"excluded labels retained as fields",
+			nil,
+			[]string{"pod_id", "namespace"},
+			true,
+			[]map[string]string{{"service": "frontend"}},
+			map[string]string{"namespace": "default", "pod_id": "pod-1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			config := &Config{
+				DimensionRollupOption:    "NoDimensionRollup",
+				IncludeDimensions:        tc.includeDimensions,
+				ExcludeDimensions:        tc.excludeDimensions,
+				RetainExcludedDimensions: tc.retain,
+				logger:                   zap.NewNop(),
+			}
+			groupedMetrics := make(map[interface{}]*groupedMetric)
+			metadata := generateTestMetricMetadata("namespace", timestamp, logGroup, logStreamName, noInstrumentationLibraryName, metric.Type())
+			err := addToGroupedMetric(metric, groupedMetrics, metadata, true, zap.NewNop(), nil, config)
+			assert.Nil(t, err)
+
+			var labels []map[string]string
+			for _, group := range groupedMetrics {
+				labels = append(labels, group.labels)
+				assert.Equal(t, tc.expectedFields, group.fields)
+
+				cWMetric := translateGroupedMetricToCWMetric(group, config)
+				dimensions := cWMetric.measurements[0].Dimensions[0]
+				assert.Equal(t, len(group.labels), len(dimensions))
+				for k, v := range tc.expectedFields {
+					assert.Equal(t, v, cWMetric.fields[k])
+					assert.NotContains(t, dimensions, k)
+				}
+			}
+			assert.ElementsMatch(t, tc.expectedLabels, labels)
+		})
+	}
+}
+
+func TestAddToGroupedMetricWithFilteredCounters(t *testing.T) {
+	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
+
+	testCases := []struct {
+		testName      string
+		metricType    pmetric.MetricType
+		monotonic     bool
+		strategy      string
+		expectedValue interface{}
+		expectedLogs  int
+	}{
+		{
+			"counters differing by a filtered out label are summed",
+			pmetric.MetricTypeSum,
+			true,
+			"",
+			float64(7),
+			0,
+		},
+		{
+			"counters differing by a filtered out label are summed regardless of the strategy",
+			pmetric.MetricTypeSum,
+			true,
+			duplicateMetricStrategyOverwrite,
+			float64(7),
+			0,
+		},
+		{
+			"non-monotonic sums differing by a filtered out label keep the strategy",
+			pmetric.MetricTypeSum,
+			false,
+			duplicateMetricStrategyOverwrite,
+			float64(4),
+			0,
+		},
+		{
+			"non-monotonic sums differing by a filtered out label are duplicates",
+			pmetric.MetricTypeSum,
+			false,
+			"",
+			float64(3),
+			1,
+		},
+		{
+			"gauges differing by a filtered out label are duplicates",
+			pmetric.MetricTypeGauge,
+			false,
+			"",
+			float64(3),
+			1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			metric := pmetric.NewMetric()
+			metric.SetName("requests")
+			var dps pmetric.NumberDataPointSlice
+			if tc.metricType == pmetric.MetricTypeSum {
+				sum := metric.SetEmptySum()
+				sum.SetIsMonotonic(tc.monotonic)
+				sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+				dps = sum.DataPoints()
+			} else {
+				dps = metric.SetEmptyGauge().DataPoints()
+			}
+			for i, podID := range []string{"pod-1", "pod-2"} {
+				dp := dps.AppendEmpty()
+				dp.SetDoubleValue(float64(3 + i))
+				dp.Attributes().PutStr("service", "frontend")
+				dp.Attributes().PutStr("pod_id", podID)
+			}
+
+			obs, logs := observer.New(zap.WarnLevel)
+			obsLogger := zap.New(obs)
+			config := &Config{
+				DimensionRollupOption:   "NoDimensionRollup",
+				ExcludeDimensions:       []string{"pod_id"},
+				DuplicateMetricStrategy: tc.strategy,
+				logger:                  obsLogger,
+			}
+			groupedMetrics := make(map[interface{}]*groupedMetric)
+			metadata := generateTestMetricMetadata("namespace", timestamp, logGroup, logStreamName, noInstrumentationLibraryName, metric.Type())
+			err := addToGroupedMetric(metric, groupedMetrics, metadata, true, obsLogger, nil, config)
+			assert.Nil(t, err)
+
+			assert.Equal(t, 1, len(groupedMetrics))
+			for _, group := range groupedMetrics {
+				assert.Equal(t, map[string]string{"service": "frontend"}, group.labels)
+				assert.Equal(t, tc.expectedValue, group.metrics["requests"].value)
+			}
+			assert.Equal(t, tc.expectedLogs, logs.Len())
+		})
+	}
+}
+
+func TestAddToGroupedMetricWithMetricNamePrefix(t *testing.T) {
+	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
+
+	metric := pmetric.NewMetric()
+	metric.SetName("latency")
+	metric.SetUnit("ms")
+	metric.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	dps := metric.Sum().DataPoints()
+	for _, value := range []float64{1, 2} {
+		dp := dps.AppendEmpty()
+		dp.SetDoubleValue(value)
+		dp.Attributes().PutStr("label1", "value1")
+	}
+
+	config := &Config{
+		MetricNamePrefix:        "collector1.",
+		DuplicateMetricStrategy: duplicateMetricStrategyAggregate,
+		DimensionRollups:        []*DimensionRollup{{MetricNameSelectors: []string{"^latency$"}}},
+		logger:                  zap.NewNop(),
+	}
+	assert.Nil(t, config.Validate())
+	descriptor := map[string]MetricDescriptor{
+		"latency": {
+			MetricName: "latency",
+			Unit:       "Seconds",
+			Overwrite:  true,
+		},
+	}
+
+	groupedMetrics := make(map[interface{}]*groupedMetric)
+	metadata := generateTestMetricMetadata("namespace", timestamp, logGroup, logStreamName, noInstrumentationLibraryName, metric.Type())
+	err := addToGroupedMetric(metric, groupedMetrics, metadata, true, zap.NewNop(), descriptor, config)
+	assert.Nil(t, err)
+
+	// The labeled group and the group rolled up without labels
+	assert.Equal(t, 2, len(groupedMetrics))
+	for _, group := range groupedMetrics {
+		assert.Equal(t, map[string]*metricInfo{
+			"collector1.latency": {
+				value: float64(3),
+				unit:  "Seconds",
+			},
+		}, group.metrics)
+	}
+}
+
+func TestAddToGroupedMetricWithMetricFilters(t *testing.T) {
+	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
+
+	var metrics []pmetric.Metric
+	for _, name := range []string{"http.server.duration", "http.server.internal.queue", "rpc.server.duration"} {
+		metric := pmetric.NewMetric()
+		metric.SetName(name)
+		metric.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(1)
+		metrics = append(metrics, metric)
+	}
+
+	testCases := []struct {
+		testName        string
+		includeMetrics  []string
+		excludeMetrics  []string
+		expectedMetrics []string
+	}{
+		{
+			"empty config",
+			nil,
+			nil,
+			[]string{"http.server.duration", "http.server.internal.queue", "rpc.server.duration"},
+		},
+		{
+			"exclude only",
+			nil,
+			[]string{"*.internal.*"},
+			[]string{"http.server.duration", "rpc.server.duration"},
+		},
+		{
+			"include only",
+			[]string{"http.*"},
+			nil,
+			[]string{"http.server.duration", "http.server.internal.queue"},
+		},
+		{
+			"include wins over exclude",
+			[]string{"http.*"},
+			[]string{"http.server.*"},
+			[]string{"http.server.duration", "http.server.internal.queue"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			config := &Config{
+				IncludeMetrics: tc.includeMetrics,
+				ExcludeMetrics: tc.excludeMetrics,
+				logger:         zap.NewNop(),
+			}
+			groupedMetrics := make(map[interface{}]*groupedMetric)
+			for _, metric := range metrics {
+				metadata := generateTestMetricMetadata("namespace", timestamp, logGroup, logStreamName, noInstrumentationLibraryName, metric.Type())
+				err := addToGroupedMetric(metric, groupedMetrics, metadata, true, zap.NewNop(), nil, config)
+				assert.Nil(t, err)
+			}
+
+			var names []string
+			for _, group := range groupedMetrics {
+				for name := range group.metrics {
+					names = append(names, name)
+				}
+			}
+			assert.ElementsMatch(t, tc.expectedMetrics, names)
+		})
+	}
+}
 
-		jsonBytes, _ := json.Marshal(expectedCreatedObj)
-		addKubernetesWrapper(inputs)
-		assert.Equal(t, string(jsonBytes), inputs["kubernetes"], "The created and expected objects should be the same")
-	})
+func TestAddToGroupedMetricWithKubernetesMetadata(t *testing.T) {
+	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
+
+	testCases := []struct {
+		testName           string
+		metricType         string
+		kubernetesMetadata bool
+		fargate            bool
+		expectedWrapper    string
+	}{
+		{
+			"node type",
+			"Node",
+			true,
+			false,
+			`{"host":"node-1"}`,
+		},
+		{
+			"pod type without fargate",
+			"Pod",
+			true,
+			false,
+			`{"host":"node-1","pod_name":"coredns"}`,
+		},
+		{
+			"node type with fargate",
+			"Node",
+			false,
+			true,
+			`{"host":"node-1"}`,
+		},
+		{
+			"cluster type",
+			"Cluster",
+			true,
+			false,
+			"",
+		},
+		{
+			"missing type",
+			"",
+			true,
+			false,
+			"",
+		},
+		{
+			"disabled",
+			"Pod",
+			false,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			metric := pmetric.NewMetric()
+			metric.SetName("cpu_utilization")
+			dp := metric.SetEmptyGauge().DataPoints().AppendEmpty()
+			dp.SetDoubleValue(0.5)
+			dp.Attributes().PutStr("NodeName", "node-1")
+			if tc.metricType != "" {
+				dp.Attributes().PutStr("Type", tc.metricType)
+			}
+			if tc.metricType == "Pod" {
+				dp.Attributes().PutStr("PodName", "coredns")
+			}
+
+			config := &Config{
+				KubernetesMetadataEnabled:          tc.kubernetesMetadata,
+				EKSFargateContainerInsightsEnabled: tc.fargate,
+				logger:                             zap.NewNop(),
+			}
+			groupedMetrics := make(map[interface{}]*groupedMetric)
+			metadata := generateTestMetricMetadata("namespace", timestamp, logGroup, logStreamName, noInstrumentationLibraryName, metric.Type())
+			err := addToGroupedMetric(metric, groupedMetrics, metadata, true, zap.NewNop(), nil, config)
+			assert.Nil(t, err)
+
+			assert.Equal(t, 1, len(groupedMetrics))
+			for _, group := range groupedMetrics {
+				if tc.expectedWrapper == "" {
+					assert.NotContains(t, group.labels, "kubernetes")
+				} else {
+					assert.Equal(t, tc.expectedWrapper, group.labels["kubernetes"])
+				}
+			}
+		})
+	}
+}
+
+func TestAddToGroupedMetricWithNonDimensionLabels(t *testing.T) {
+	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
+
+	metric := pmetric.NewMetric()
+	metric.SetName("requests")
+	dps := metric.SetEmptyGauge().DataPoints()
+	for _, requestID := range []string{"request-1", "request-2"} {
+		dp := dps.AppendEmpty()
+		dp.SetDoubleValue(1)
+		dp.Attributes().PutStr("service", "frontend")
+		dp.Attributes().PutStr("request_id", requestID)
+		dp.Attributes().PutStr("pod_id", "pod-1")
+	}
+
+	config := &Config{
+		DimensionRollupOption: "NoDimensionRollup",
+		NonDimensionLabels:    []string{"request_id"},
+		ExcludeDimensions:     []string{"pod_id"},
+		logger:                zap.NewNop(),
+	}
+	groupedMetrics := make(map[interface{}]*groupedMetric)
+	metadata := generateTestMetricMetadata("namespace", timestamp, logGroup, logStreamName, noInstrumentationLibraryName, metric.Type())
+	err := addToGroupedMetric(metric, groupedMetrics, metadata, true, zap.NewNop(), nil, config)
+	assert.Nil(t, err)
+
+	// The non-dimension label does not affect grouping
+	assert.Equal(t, 1, len(groupedMetrics))
+	for _, group := range groupedMetrics {
+		assert.Equal(t, map[string]string{"service": "frontend"}, group.labels)
+		// The excluded label is not retained as RetainExcludedDimensions is not set
+		assert.Equal(t, map[string]string{"request_id": "request-1"}, group.fields)
+
+		cWMetric := translateGroupedMetricToCWMetric(group, config)
+		assert.Equal(t, "request-1", cWMetric.fields["request_id"])
+		assert.NotContains(t, cWMetric.fields, "pod_id")
+		assert.Equal(t, [][]string{{"service"}}, cWMetric.measurements[0].Dimensions)
+	}
+}
+
+func TestAddToGroupedMetricWithTimestampStrategy(t *testing.T) {
+	receiveTimestamp := time.Now().UnixNano() / int64(time.Millisecond)
+	firstTimestamp := receiveTimestamp - 2000
+	latestTimestamp := receiveTimestamp - 1000
+	earliestTimestamp := receiveTimestamp - 3000
+
+	testCases := []struct {
+		name               string
+		timestampStrategy  string
+		timestamps         []int64
+		expectedTimestamps []int64
+	}{
+		{
+			name:               "first keeps different timestamps apart",
+			timestampStrategy:  "",
+			timestamps:         []int64{firstTimestamp, latestTimestamp, earliestTimestamp},
+			expectedTimestamps: []int64{earliestTimestamp, firstTimestamp, latestTimestamp},
+		},
+		{
+			name:               "latest",
+			timestampStrategy:  "latest",
+			timestamps:         []int64{firstTimestamp, latestTimestamp, earliestTimestamp},
+			expectedTimestamps: []int64{latestTimestamp},
+		},
+		{
+			name:               "earliest",
+			timestampStrategy:  "earliest",
+			timestamps:         []int64{firstTimestamp, latestTimestamp, earliestTimestamp},
+			expectedTimestamps: []int64{earliestTimestamp},
+		},
+		{
+			name:               "latest with zero timestamp falls back to receive time",
+			timestampStrategy:  "latest",
+			timestamps:         []int64{firstTimestamp, 0},
+			expectedTimestamps: []int64{receiveTimestamp},
+		},
+		{
+			name:               "earliest with zero timestamp falls back to receive time",
+			timestampStrategy:  "earliest",
+			timestamps:         []int64{0},
+			expectedTimestamps: []int64{receiveTimestamp},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := &Config{
+				DimensionRollupOption: "NoDimensionRollup",
+				TimestampStrategy:     tc.timestampStrategy,
+				logger:                zap.NewNop(),
+			}
+			groupedMetrics := make(map[interface{}]*groupedMetric)
+			metadata := generateTestMetricMetadata("namespace", receiveTimestamp, logGroup, logStreamName, noInstrumentationLibraryName, pmetric.MetricTypeGauge)
+			for i, timestamp := range tc.timestamps {
+				metric := pmetric.NewMetric()
+				metric.SetName(fmt.Sprintf("metric_%d", i))
+				dp := metric.SetEmptyGauge().DataPoints().AppendEmpty()
+				dp.SetDoubleValue(1)
+				dp.SetTimestamp(pcommon.Timestamp(timestamp * int64(time.Millisecond)))
+				err := addToGroupedMetric(metric, groupedMetrics, metadata, true, zap.NewNop(), nil, config)
+				assert.Nil(t, err)
+			}
+
+			var timestamps []int64
+			for _, group := range groupedMetrics {
+				timestamps = append(timestamps, group.metadata.timestampMs)
+			}
+			sort.Slice(timestamps, func(i, j int) bool { return timestamps[i] < timestamps[j] })
+			assert.Equal(t, tc.expectedTimestamps, timestamps)
+		})
+	}
+}
+
+func TestAddToGroupedMetricWithUnitValidation(t *testing.T) {
+	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
+
+	testCases := []struct {
+		name           string
+		unit           string
+		monotonic      bool
+		value          float64
+		validateUnits  bool
+		expectedReason string
+	}{
+		{
+			name:           "counter as percentage",
+			unit:           "Percent",
+			monotonic:      true,
+			value:          50,
+			validateUnits:  true,
+			expectedReason: "a counter cannot be a percentage",
+		},
+		{
+			name:           "counter as rate",
+			unit:           "By/s",
+			monotonic:      true,
+			value:          1024,
+			validateUnits:  true,
+			expectedReason: "a counter cannot be a rate",
+		},
+		{
+			name:           "fraction of bytes",
+			unit:           "By",
+			value:          0.001,
+			validateUnits:  true,
+			expectedReason: "a value below 1 is a fraction of an indivisible unit",
+		},
+		{
+			name:          "gauge as percentage",
+			unit:          "Percent",
+			value:         50,
+			validateUnits: true,
+		},
+		{
+			name:          "counter of bytes",
+			unit:          "By",
+			monotonic:     true,
+			value:         1024,
+			validateUnits: true,
+		},
+		{
+			name:      "validation disabled",
+			unit:      "Percent",
+			monotonic: true,
+			value:     50,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metric := pmetric.NewMetric()
+			metric.SetName("foo")
+			metric.SetUnit(tc.unit)
+			sum := metric.SetEmptySum()
+			sum.SetIsMonotonic(tc.monotonic)
+			sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+			sum.DataPoints().AppendEmpty().SetDoubleValue(tc.value)
+
+			obs, logs := observer.New(zap.WarnLevel)
+			obsLogger := zap.New(obs)
+			config := &Config{
+				DimensionRollupOption: "NoDimensionRollup",
+				ValidateUnits:         tc.validateUnits,
+				logger:                obsLogger,
+			}
+			groupedMetrics := make(map[interface{}]*groupedMetric)
+			metadata := generateTestMetricMetadata("namespace", timestamp, logGroup, logStreamName, noInstrumentationLibraryName, metric.Type())
+			err := addToGroupedMetric(metric, groupedMetrics, metadata, true, obsLogger, nil, config)
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(groupedMetrics))
+
+			if tc.expectedReason == "" {
+				assert.Equal(t, 0, logs.Len())
+				return
+			}
+			unit, _ := translateUnit(metric, nil, false)
+			expectedLogs := []observer.LoggedEntry{
+				{
+					Entry: zapcore.Entry{Level: zap.WarnLevel, Message: "Unit incompatible with metric"},
+					Context: []zapcore.Field{
+						zap.String("Name", "foo"),
+						zap.String("Unit", unit),
+						zap.String("Reason", tc.expectedReason),
+					},
+				},
+			}
+			assert.Equal(t, expectedLogs, logs.AllUntimed())
+		})
+	}
 }
 
 func BenchmarkAddToGroupedMetric(b *testing.B) {
@@ -533,24 +1656,435 @@ func TestTranslateUnit(t *testing.T) {
 		},
 	}
 
-	translateUnitCases := map[string]string{
-		"Count": "Count",
-		"ms":    "Milliseconds",
-		"s":     "Seconds",
-		"us":    "Microseconds",
-		"By":    "Bytes",
-		"Bi":    "Bits",
+	translateUnitCases := []struct {
+		input         string
+		expectedUnit  string
+		expectedScale float64
+	}{
+		{"Count", "Count", 1},
+		{"ms", "Milliseconds", 1},
+		{"s", "Seconds", 1},
+		{"us", "Microseconds", 1},
+		{"ns", "Microseconds", 1e-3},
+		{"By", "Bytes", 1},
+		{"Bi", "Bits", 1},
+		{"By/s", "Bytes/Second", 1},
+		{"bit/s", "Bits/Second", 1},
+		{"{count}", "Count", 1},
+		{"1", "None", 1},
 	}
-	for input, output := range translateUnitCases {
-		t.Run(input, func(tt *testing.T) {
-			metric.SetUnit(input)
+	for _, tc := range translateUnitCases {
+		t.Run(tc.input, func(tt *testing.T) {
+			metric.SetUnit(tc.input)
 
-			v := translateUnit(metric, translator.metricDescriptor)
-			assert.Equal(t, output, v)
+			unit, scale := translateUnit(metric, translator.metricDescriptor, false)
+			assert.Equal(t, tc.expectedUnit, unit)
+			assert.Equal(t, tc.expectedScale, scale)
 		})
 	}
 
 	metric.SetName("forceOverwrite")
-	v := translateUnit(metric, translator.metricDescriptor)
-	assert.Equal(t, "Count", v)
+	metric.SetUnit("ns")
+	unit, scale := translateUnit(metric, translator.metricDescriptor, false)
+	assert.Equal(t, "Count", unit)
+	assert.Equal(t, float64(1), scale)
+}
+
+func TestTranslateUnitWithWildcardDescriptors(t *testing.T) {
+	descriptor := map[string]MetricDescriptor{
+		"http.server.*": {
+			MetricName: "http.server.*",
+			Unit:       "Milliseconds",
+			Overwrite:  true,
+		},
+		"http.server.*.size": {
+			MetricName: "http.server.*.size",
+			Unit:       "Bytes",
+			Overwrite:  true,
+		},
+		"*.size": {
+			MetricName: "*.size",
+			Unit:       "Kilobytes",
+			Overwrite:  true,
+		},
+		"http.server.active_requests": {
+			MetricName: "http.server.active_requests",
+			Unit:       "Count",
+			Overwrite:  true,
+		},
+		"rpc.*": {
+			MetricName: "rpc.*",
+			Unit:       "Seconds",
+			Overwrite:  false,
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		unit         string
+		expectedUnit string
+	}{
+		{"http.server.duration", "ms", "Milliseconds"},
+		{"http.server.request.size", "By", "Bytes"},
+		{"db.response.size", "By", "Kilobytes"},
+		{"http.server.active_requests", "{count}", "Count"},
+		{"http.client.duration", "ms", "Milliseconds"},
+		{"rpc.server.duration", "", "Seconds"},
+		{"rpc.server.duration", "ms", "Milliseconds"},
+		{"http.server", "", ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name+"_"+tc.unit, func(t *testing.T) {
+			metric := pmetric.NewMetric()
+			metric.SetName(tc.name)
+			metric.SetUnit(tc.unit)
+
+			unit, _ := translateUnit(metric, descriptor, false)
+			assert.Equal(t, tc.expectedUnit, unit)
+		})
+	}
+}
+
+func TestMatchWildcard(t *testing.T) {
+	testCases := []struct {
+		pattern  string
+		name     string
+		expected bool
+	}{
+		{"http.*", "http.server.duration", true},
+		{"http.*", "rpc.server.duration", false},
+		{"*.duration", "http.server.duration", true},
+		{"http.*.duration", "http.server.duration", true},
+		{"http.*.duration", "http.server.size", false},
+		{"http.*.*.size", "http.server.request.size", true},
+		{"*", "anything", true},
+		{"a*a", "a", false},
+		{"http.server", "http.server", true},
+		{"http.server", "http.server.duration", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.pattern+"_"+tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, matchWildcard(tc.pattern, tc.name))
+		})
+	}
+}
+
+func TestTranslateUnitWithPreservedUCUMUnits(t *testing.T) {
+	descriptor := map[string]MetricDescriptor{
+		"forceOverwrite": {
+			MetricName: "forceOverwrite",
+			Unit:       "Count",
+			Overwrite:  true,
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		unit         string
+		expectedUnit string
+	}{
+		{"preserved", "ms", "ms"},
+		{"preserved", "By", "By"},
+		{"preserved", "ns", "ns"},
+		{"forceOverwrite", "ms", "Count"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name+"_"+tc.unit, func(t *testing.T) {
+			metric := pmetric.NewMetric()
+			metric.SetName(tc.name)
+			metric.SetUnit(tc.unit)
+
+			unit, scale := translateUnit(metric, descriptor, true)
+			assert.Equal(t, tc.expectedUnit, unit)
+			assert.Equal(t, float64(1), scale)
+		})
+	}
+}
+
+func TestAddToGroupedMetricWithNanoseconds(t *testing.T) {
+	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
+
+	gauge := pmetric.NewMetric()
+	gauge.SetName("gauge")
+	gauge.SetUnit("ns")
+	gauge.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(1500)
+
+	histogram := pmetric.NewMetric()
+	histogram.SetName("histogram")
+	histogram.SetUnit("ns")
+	dp := histogram.SetEmptyHistogram().DataPoints().AppendEmpty()
+	dp.SetCount(2)
+	dp.SetSum(3000)
+	dp.SetMin(1000)
+	dp.SetMax(2000)
+
+	testCases := []struct {
+		testName      string
+		metric        pmetric.Metric
+		expectedValue interface{}
+	}{
+		{
+			"gauge",
+			gauge,
+			1.5,
+		},
+		{
+			"histogram",
+			histogram,
+			&cWMetricStats{Count: 2, Sum: 3, Min: 1, Max: 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			groupedMetrics := make(map[interface{}]*groupedMetric)
+			metadata := generateTestMetricMetadata("namespace", timestamp, logGroup, logStreamName, "cloudwatch-otel", tc.metric.Type())
+			err := addToGroupedMetric(tc.metric, groupedMetrics, metadata, true, zap.NewNop(), nil, nil)
+			assert.Nil(t, err)
+
+			assert.Equal(t, 1, len(groupedMetrics))
+			for _, group := range groupedMetrics {
+				assert.Equal(t, map[string]*metricInfo{
+					tc.metric.Name(): {
+						value: tc.expectedValue,
+						unit:  "Microseconds",
+					},
+				}, group.metrics)
+			}
+		})
+	}
+}
+
+func TestAddToGroupedMetricWithScaleFactor(t *testing.T) {
+	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
+
+	newGauge := func(unit string, setValue func(dp pmetric.NumberDataPoint)) pmetric.Metric {
+		gauge := pmetric.NewMetric()
+		gauge.SetName("cpu.utilization")
+		gauge.SetUnit(unit)
+		setValue(gauge.SetEmptyGauge().DataPoints().AppendEmpty())
+		return gauge
+	}
+	fraction := func(dp pmetric.NumberDataPoint) { dp.SetDoubleValue(0.5) }
+
+	testCases := []struct {
+		testName     string
+		metric       pmetric.Metric
+		descriptor   MetricDescriptor
+		expectedInfo *metricInfo
+	}{
+		{
+			"fraction to percent with unit overwrite",
+			newGauge("1", fraction),
+			MetricDescriptor{MetricName: "cpu.utilization", Unit: "Percent", Overwrite: true, ScaleFactor: 100},
+			&metricInfo{value: float64(50), unit: "Percent"},
+		},
+		{
+			"fraction to percent without unit overwrite",
+			newGauge("1", fraction),
+			MetricDescriptor{MetricName: "cpu.utilization", Unit: "Percent", ScaleFactor: 100},
+			&metricInfo{value: float64(50), unit: "None"},
+		},
+		{
+			"fraction to percent with unit set if empty",
+			newGauge("", fraction),
+			MetricDescriptor{MetricName: "cpu.utilization", Unit: "Percent", ScaleFactor: 100},
+			&metricInfo{value: float64(50), unit: "Percent"},
+		},
+		{
+			"int gauge",
+			newGauge("", func(dp pmetric.NumberDataPoint) { dp.SetIntValue(3) }),
+			MetricDescriptor{MetricName: "cpu.utilization", ScaleFactor: 0.5},
+			&metricInfo{value: float64(1.5), unit: ""},
+		},
+		{
+			"combined with unit translation",
+			newGauge("ns", func(dp pmetric.NumberDataPoint) { dp.SetDoubleValue(1500) }),
+			MetricDescriptor{MetricName: "cpu.*", ScaleFactor: 2},
+			&metricInfo{value: float64(3), unit: "Microseconds"},
+		},
+		{
+			"no scale factor",
+			newGauge("1", fraction),
+			MetricDescriptor{MetricName: "cpu.utilization", Unit: "Percent", Overwrite: true},
+			&metricInfo{value: float64(0.5), unit: "Percent"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			descriptors := map[string]MetricDescriptor{tc.descriptor.MetricName: tc.descriptor}
+			groupedMetrics := make(map[interface{}]*groupedMetric)
+			metadata := generateTestMetricMetadata("namespace", timestamp, logGroup, logStreamName, "cloudwatch-otel", tc.metric.Type())
+			err := addToGroupedMetric(tc.metric, groupedMetrics, metadata, true, zap.NewNop(), descriptors, nil)
+			assert.Nil(t, err)
+
+			assert.Equal(t, 1, len(groupedMetrics))
+			for _, group := range groupedMetrics {
+				assert.Equal(t, map[string]*metricInfo{"cpu.utilization": tc.expectedInfo}, group.metrics)
+			}
+		})
+	}
+}
+
+func TestAddToGroupedMetricWithDatePatterns(t *testing.T) {
+	gauge := pmetric.NewMetric()
+	gauge.SetName("cpu.utilization")
+	dps := gauge.SetEmptyGauge().DataPoints()
+	for _, ts := range []time.Time{
+		time.Date(2024, time.June, 1, 23, 30, 0, 0, time.UTC),
+		time.Date(2024, time.June, 2, 0, 30, 0, 0, time.UTC),
+	} {
+		dp := dps.AppendEmpty()
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+		dp.SetDoubleValue(0.5)
+	}
+
+	groupedMetrics := make(map[interface{}]*groupedMetric)
+	metadata := generateTestMetricMetadata("namespace", 0, "/metrics/{date:2006}", "metrics-{date:2006-01-02}", "cloudwatch-otel", gauge.Type())
+	err := addToGroupedMetric(gauge, groupedMetrics, metadata, true, zap.NewNop(), nil, nil)
+	assert.Nil(t, err)
+
+	logStreams := make([]string, 0, len(groupedMetrics))
+	for _, group := range groupedMetrics {
+		assert.Equal(t, "/metrics/2024", group.metadata.logGroup)
+		logStreams = append(logStreams, group.metadata.logStream)
+	}
+	assert.ElementsMatch(t, []string{"metrics-2024-06-01", "metrics-2024-06-02"}, logStreams)
+}
+
+func TestAddToGroupedMetricWithUnresolvedPatternsWithoutConfig(t *testing.T) {
+	gauge := pmetric.NewMetric()
+	gauge.SetName("cpu.utilization")
+	dp := gauge.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetDoubleValue(0.5)
+	dp.Attributes().PutStr("ClusterName", "cluster-1")
+
+	// Without a config the unresolved log group and stream are kept as is
+	groupedMetrics := make(map[interface{}]*groupedMetric)
+	metadata := generateTestMetricMetadata("namespace", 0, "/metrics/{ClusterName}", "{TaskId}", "cloudwatch-otel", gauge.Type())
+	metadata.logGroupUnresolved = true
+	metadata.logStreamUnresolved = true
+	require.NotPanics(t, func() {
+		assert.NoError(t, addToGroupedMetric(gauge, groupedMetrics, metadata, false, zap.NewNop(), nil, nil))
+	})
+	require.Equal(t, 1, len(groupedMetrics))
+	for _, group := range groupedMetrics {
+		assert.Equal(t, "/metrics/{ClusterName}", group.metadata.logGroup)
+		assert.Equal(t, "{TaskId}", group.metadata.logStream)
+	}
+}
+
+func TestAddToGroupedMetricWithInvalidValuePolicy(t *testing.T) {
+	generateGauge := func(value float64) pmetric.Metric {
+		gauge := pmetric.NewMetric()
+		gauge.SetName("invalid_gauge")
+		dps := gauge.SetEmptyGauge().DataPoints()
+		dp := dps.AppendEmpty()
+		dp.SetDoubleValue(value)
+		dp.Attributes().PutStr("label", "invalid")
+		dp = dps.AppendEmpty()
+		dp.SetDoubleValue(1.5)
+		dp.Attributes().PutStr("label", "valid")
+		return gauge
+	}
+
+	testCases := []struct {
+		name           string
+		policy         string
+		value          float64
+		expectedValues map[string]interface{}
+		expectedErr    string
+	}{
+		{
+			name:           "default drops NaN",
+			policy:         "",
+			value:          math.NaN(),
+			expectedValues: map[string]interface{}{"valid": 1.5},
+		},
+		{
+			name:           "drop NaN",
+			policy:         invalidValuePolicyDrop,
+			value:          math.NaN(),
+			expectedValues: map[string]interface{}{"valid": 1.5},
+		},
+		{
+			name:           "drop +Inf",
+			policy:         invalidValuePolicyDrop,
+			value:          math.Inf(1),
+			expectedValues: map[string]interface{}{"valid": 1.5},
+		},
+		{
+			name:           "zero NaN",
+			policy:         invalidValuePolicyZero,
+			value:          math.NaN(),
+			expectedValues: map[string]interface{}{"invalid": 0.0, "valid": 1.5},
+		},
+		{
+			name:           "zero +Inf",
+			policy:         invalidValuePolicyZero,
+			value:          math.Inf(1),
+			expectedValues: map[string]interface{}{"invalid": 0.0, "valid": 1.5},
+		},
+		{
+			name:        "error NaN",
+			policy:      invalidValuePolicyError,
+			value:       math.NaN(),
+			expectedErr: `Permanent error: metric "invalid_gauge" has a NaN or infinite value`,
+		},
+		{
+			name:        "error +Inf",
+			policy:      invalidValuePolicyError,
+			value:       math.Inf(1),
+			expectedErr: `Permanent error: metric "invalid_gauge" has a NaN or infinite value`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gauge := generateGauge(tc.value)
+			config := &Config{
+				InvalidValuePolicy: tc.policy,
+				logger:             zap.NewNop(),
+			}
+			groupedMetrics := make(map[interface{}]*groupedMetric)
+			metadata := generateTestMetricMetadata("namespace", 1000, "log-group", "log-stream", "cloudwatch-otel", gauge.Type())
+			err := addToGroupedMetric(gauge, groupedMetrics, metadata, true, zap.NewNop(), nil, config)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				assert.True(t, consumererror.IsPermanent(err))
+				return
+			}
+			require.NoError(t, err)
+
+			values := make(map[string]interface{})
+			for _, group := range groupedMetrics {
+				values[group.labels["label"]] = group.metrics["invalid_gauge"].value
+			}
+			assert.Equal(t, tc.expectedValues, values)
+		})
+	}
+}
+
+func TestAddToGroupedMetricWithInvalidHistogramValues(t *testing.T) {
+	histogram := pmetric.NewMetric()
+	histogram.SetName("invalid_histogram")
+	dp := histogram.SetEmptyHistogram().DataPoints().AppendEmpty()
+	dp.SetCount(2)
+	dp.SetSum(math.Inf(1))
+	dp.SetMin(1)
+	dp.SetMax(math.Inf(1))
+
+	config := &Config{
+		InvalidValuePolicy: invalidValuePolicyZero,
+		logger:             zap.NewNop(),
+	}
+	groupedMetrics := make(map[interface{}]*groupedMetric)
+	metadata := generateTestMetricMetadata("namespace", 1000, "log-group", "log-stream", "cloudwatch-otel", histogram.Type())
+	err := addToGroupedMetric(histogram, groupedMetrics, metadata, true, zap.NewNop(), nil, config)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(groupedMetrics))
+	for _, group := range groupedMetrics {
+		assert.Equal(t, &cWMetricStats{Count: 2, Sum: 0, Min: 1, Max: 0}, group.metrics["invalid_histogram"].value)
+	}
 }
@@ -84,10 +84,28 @@ func (m *MetricDeclaration) init(logger *zap.Logger) (err error) {
 		return errors.New("invalid metric declaration: no metric name selectors defined")
 	}
 
-	// Filter out duplicate dimension sets and those with more than 10 elements
-	validDims := make([][]string, 0, len(m.Dimensions))
-	seen := make(map[string]bool, len(m.Dimensions))
-	for _, dimSet := range m.Dimensions {
+	m.Dimensions = normalizeDimensionSets(m.Dimensions, logger)
+
+	m.metricRegexList = make([]*regexp.Regexp, len(m.MetricNameSelectors))
+	for i, selector := range m.MetricNameSelectors {
+		m.metricRegexList[i] = regexp.MustCompile(selector)
+	}
+
+	// Initialize label matchers
+	for _, lm := range m.LabelMatchers {
+		if err := lm.init(); err != nil {
+			return err
+		}
+	}
+	return
+}
+
+// normalizeDimensionSets filters out duplicate dimension sets and those with more than 10 elements. The dimensions
+// of each set are deduped and sorted.
+func normalizeDimensionSets(dimensions [][]string, logger *zap.Logger) [][]string {
+	validDims := make([][]string, 0, len(dimensions))
+	seen := make(map[string]bool, len(dimensions))
+	for _, dimSet := range dimensions {
 		concatenatedDims := strings.Join(dimSet, ",")
 		if len(dimSet) > 10 {
 			logger.Warn("Dropped dimension set: > 10 dimensions specified.", zap.String("dimensions", concatenatedDims))
@@ -100,6 +118,11 @@ func (m *MetricDeclaration) init(logger *zap.Logger) (err error) {
 			logger.Debug("Removed duplicates from dimension set.", zap.String("dimensions", concatenatedDims))
 		}
 
+		if dedupedDims == nil {
+			// An empty dimension set, e.g. [[]] in the configuration, is emitted as [] rather than null
+			dedupedDims = []string{}
+		}
+
 		// Sort dimensions
 		sort.Strings(dedupedDims)
 
@@ -112,20 +135,7 @@ func (m *MetricDeclaration) init(logger *zap.Logger) (err error) {
 		seen[key] = true
 		validDims = append(validDims, dedupedDims)
 	}
-	m.Dimensions = validDims
-
-	m.metricRegexList = make([]*regexp.Regexp, len(m.MetricNameSelectors))
-	for i, selector := range m.MetricNameSelectors {
-		m.metricRegexList[i] = regexp.MustCompile(selector)
-	}
-
-	// Initialize label matchers
-	for _, lm := range m.LabelMatchers {
-		if err := lm.init(); err != nil {
-			return err
-		}
-	}
-	return
+	return validDims
 }
 
 // MatchesName returns true if the given OTLP Metric's name matches any of the Metric
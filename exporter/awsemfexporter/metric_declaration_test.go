@@ -254,6 +254,16 @@ func TestMetricDeclarationInit(t *testing.T) {
 		assert.Equal(t, 2, len(m.Dimensions))
 	})
 
+	t.Run("empty dimension set", func(t *testing.T) {
+		m := &MetricDeclaration{
+			Dimensions:          [][]string{nil, {"foo"}},
+			MetricNameSelectors: []string{"a"},
+		}
+		err := m.init(logger)
+		assert.Nil(t, err)
+		assert.Equal(t, [][]string{{}, {"foo"}}, m.Dimensions)
+	})
+
 	// Test removal of dimension sets with more than 10 elements
 	t.Run("dimension set with more than 10 elements", func(t *testing.T) {
 		m := &MetricDeclaration{
@@ -18,6 +18,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -36,11 +38,47 @@ const (
 	zeroAndSingleDimensionRollup = "ZeroAndSingleDimensionRollup"
 	singleDimensionRollupOnly    = "SingleDimensionRollupOnly"
 
+	// LabelValueNewlineHandling modes
+	newlineHandlingNone    = "none"
+	newlineHandlingStrip   = "strip"
+	newlineHandlingReplace = "replace"
+
+	// DuplicateMetricStrategies
+	duplicateMetricStrategyDrop      = "drop"
+	duplicateMetricStrategyOverwrite = "overwrite"
+	duplicateMetricStrategyAggregate = "aggregate"
+
+	// TimestampStrategies
+	timestampStrategyFirst    = "first"
+	timestampStrategyLatest   = "latest"
+	timestampStrategyEarliest = "earliest"
+
+	// InvalidValuePolicies
+	invalidValuePolicyDrop  = "drop"
+	invalidValuePolicyZero  = "zero"
+	invalidValuePolicyError = "error"
+
+	// StorageResolutions
+	storageResolutionHigh     = 1
+	storageResolutionStandard = 60
+
+	// defaultUnresolvedPatternPlaceholder replaces the log group and stream name patterns that cannot be resolved
+	defaultUnresolvedPatternPlaceholder = "undefined"
+
 	prometheusReceiver        = "prometheus"
 	attributeReceiver         = "receiver"
 	fieldPrometheusMetricType = "prom_metric_type"
+	fieldTemporality          = "temporality"
+	fieldScopeName            = "otel_scope_name"
+	fieldScopeVersion         = "otel_scope_version"
+	fieldUnitSuffix           = ".unit"
 )
 
+var fieldTemporalities = map[pmetric.AggregationTemporality]string{
+	pmetric.AggregationTemporalityDelta:      "delta",
+	pmetric.AggregationTemporalityCumulative: "cumulative",
+}
+
 var fieldPrometheusTypes = map[pmetric.MetricType]string{
 	pmetric.MetricTypeEmpty:     "",
 	pmetric.MetricTypeGauge:     "gauge",
@@ -58,7 +96,7 @@ type cWMetrics struct {
 type cWMeasurement struct {
 	Namespace  string
 	Dimensions [][]string
-	Metrics    []map[string]string
+	Metrics    []map[string]interface{}
 }
 
 type cWMetricStats struct {
@@ -74,6 +112,13 @@ type groupedMetricMetadata struct {
 	logGroup       string
 	logStream      string
 	metricDataType pmetric.MetricType
+	// resourceIdentity is the value of the configured resource identity attribute, used to keep metrics from different sources apart
+	resourceIdentity string
+	// temporality is the aggregation temporality of sum metrics, set only when EmitTemporality is enabled
+	temporality pmetric.AggregationTemporality
+	// scopeName and scopeVersion are the name and version of the instrumentation scope, set only when IncludeScope is enabled
+	scopeName    string
+	scopeVersion string
 }
 
 // cWMetricMetadata represents the metadata associated with a given CloudWatch metric
@@ -81,6 +126,15 @@ type cWMetricMetadata struct {
 	groupedMetricMetadata
 	instrumentationLibraryName string
 	receiver                   string
+	// exponentialHistogramPercentilesEnabled enables the estimation of percentiles from exponential histogram buckets
+	exponentialHistogramPercentilesEnabled bool
+	// logGroupUnresolved and logStreamUnresolved are set if the patterns of the log group or log stream name
+	// could not be resolved from the resource attributes
+	logGroupUnresolved  bool
+	logStreamUnresolved bool
+	// resourceDimensions are the resource attributes named in ResourceAttributesAsDimensions, added to the labels of
+	// the data points that do not have a label with the same name
+	resourceDimensions map[string]string
 }
 
 type metricTranslator struct {
@@ -102,13 +156,29 @@ func (mt metricTranslator) translateOTelToGroupedMetric(rm pmetric.ResourceMetri
 	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
 	var instrumentationLibName string
 	cWNamespace := getNamespace(rm, config.Namespace)
-	logGroup, logStream, patternReplaceSucceeded := getLogInfo(rm, cWNamespace, config)
+	logGroup, logStream, logGroupReplaced, logStreamReplaced := getLogInfo(rm, cWNamespace, config)
+	patternReplaceSucceeded := logGroupReplaced && logStreamReplaced
 
 	ilms := rm.ScopeMetrics()
 	var metricReceiver string
 	if receiver, ok := rm.Resource().Attributes().Get(attributeReceiver); ok {
 		metricReceiver = receiver.Str()
 	}
+	var resourceIdentity string
+	if config.ResourceIdentityAttribute != "" {
+		if identity, ok := rm.Resource().Attributes().Get(config.ResourceIdentityAttribute); ok {
+			resourceIdentity = identity.AsString()
+		}
+	}
+	var resourceDimensions map[string]string
+	if len(config.ResourceAttributesAsDimensions) > 0 {
+		resourceDimensions = make(map[string]string, len(config.ResourceAttributesAsDimensions))
+		for _, name := range config.ResourceAttributesAsDimensions {
+			if attr, ok := rm.Resource().Attributes().Get(name); ok {
+				resourceDimensions[name] = attr.AsString()
+			}
+		}
+	}
 	for j := 0; j < ilms.Len(); j++ {
 		ilm := ilms.At(j)
 		if ilm.Scope().Name() == "" {
@@ -122,14 +192,26 @@ func (mt metricTranslator) translateOTelToGroupedMetric(rm pmetric.ResourceMetri
 			metric := metrics.At(k)
 			metadata := cWMetricMetadata{
 				groupedMetricMetadata: groupedMetricMetadata{
-					namespace:      cWNamespace,
-					timestampMs:    timestamp,
-					logGroup:       logGroup,
-					logStream:      logStream,
-					metricDataType: metric.Type(),
+					namespace:        cWNamespace,
+					timestampMs:      timestamp,
+					logGroup:         logGroup,
+					logStream:        logStream,
+					metricDataType:   metric.Type(),
+					resourceIdentity: resourceIdentity,
 				},
-				instrumentationLibraryName: instrumentationLibName,
-				receiver:                   metricReceiver,
+				instrumentationLibraryName:             instrumentationLibName,
+				receiver:                               metricReceiver,
+				exponentialHistogramPercentilesEnabled: config.ExponentialHistogramPercentilesEnabled,
+				logGroupUnresolved:                     !logGroupReplaced,
+				logStreamUnresolved:                    !logStreamReplaced,
+				resourceDimensions:                     resourceDimensions,
+			}
+			if config.EmitTemporality && metric.Type() == pmetric.MetricTypeSum {
+				metadata.temporality = metric.Sum().AggregationTemporality()
+			}
+			if config.IncludeScope {
+				metadata.scopeName = ilm.Scope().Name()
+				metadata.scopeVersion = ilm.Scope().Version()
 			}
 			err := addToGroupedMetric(metric, groupedMetrics, metadata, patternReplaceSucceeded, config.logger, mt.metricDescriptor, config)
 			if err != nil {
@@ -143,14 +225,34 @@ func (mt metricTranslator) translateOTelToGroupedMetric(rm pmetric.ResourceMetri
 // translateGroupedMetricToCWMetric converts Grouped Metric format to CloudWatch Metric format.
 func translateGroupedMetricToCWMetric(groupedMetric *groupedMetric, config *Config) *cWMetrics {
 	labels := groupedMetric.labels
-	fieldsLength := len(labels) + len(groupedMetric.metrics)
+	fieldsLength := len(labels) + len(groupedMetric.fields) + len(groupedMetric.metrics)
+	for _, metricInfo := range groupedMetric.metrics {
+		fieldsLength += len(metricInfo.extraFields)
+		if config.EmitUnitAsField && metricInfo.unit != "" {
+			fieldsLength++
+		}
+	}
 
 	isPrometheusMetric := groupedMetric.metadata.receiver == prometheusReceiver
 	if isPrometheusMetric {
 		fieldsLength++
 	}
+	temporality, hasTemporality := fieldTemporalities[groupedMetric.metadata.temporality]
+	if hasTemporality {
+		fieldsLength++
+	}
+	if groupedMetric.metadata.scopeName != "" {
+		fieldsLength++
+	}
+	if groupedMetric.metadata.scopeVersion != "" {
+		fieldsLength++
+	}
 	fields := make(map[string]interface{}, fieldsLength)
 
+	// Add labels excluded from the dimensions to fields
+	for k, v := range groupedMetric.fields {
+		fields[k] = v
+	}
 	// Add labels to fields
 	for k, v := range labels {
 		fields[k] = v
@@ -158,23 +260,41 @@ func translateGroupedMetricToCWMetric(groupedMetric *groupedMetric, config *Conf
 	// Add metrics to fields
 	for metricName, metricInfo := range groupedMetric.metrics {
 		fields[metricName] = metricInfo.value
+		// Add extra fields such as estimated percentiles as separate fields, e.g. "latency_p99"
+		for suffix, value := range metricInfo.extraFields {
+			fields[metricName+"_"+suffix] = value
+		}
+		// Add the unit as a separate field to be able to query it, e.g. "latency.unit"
+		if config.EmitUnitAsField && metricInfo.unit != "" {
+			fields[metricName+fieldUnitSuffix] = metricInfo.unit
+		}
 	}
 	if isPrometheusMetric {
 		fields[fieldPrometheusMetricType] = fieldPrometheusTypes[groupedMetric.metadata.metricDataType]
 	}
+	if hasTemporality {
+		fields[fieldTemporality] = temporality
+	}
+	if groupedMetric.metadata.scopeName != "" {
+		fields[fieldScopeName] = groupedMetric.metadata.scopeName
+	}
+	if groupedMetric.metadata.scopeVersion != "" {
+		fields[fieldScopeVersion] = groupedMetric.metadata.scopeVersion
+	}
 
 	var cWMeasurements []cWMeasurement
 	if len(config.MetricDeclarations) == 0 {
 		// If there are no metric declarations defined, translate grouped metric
-		// into the corresponding CW Measurement
-		cwm := groupedMetricToCWMeasurement(groupedMetric, config)
-		cWMeasurements = []cWMeasurement{cwm}
+		// into the corresponding CW Measurements
+		cWMeasurements = groupedMetricToCWMeasurements(groupedMetric, config)
 	} else {
 		// If metric declarations are defined, filter grouped metric's metrics using
 		// metric declarations and translate into the corresponding list of CW Measurements
 		cWMeasurements = groupedMetricToCWMeasurementsWithFilters(groupedMetric, config)
 	}
 
+	handleLabelValueNewlines(fields, labels, cWMeasurements, config)
+
 	return &cWMetrics{
 		measurements: cWMeasurements,
 		timestampMs:  groupedMetric.metadata.timestampMs,
@@ -182,6 +302,46 @@ func translateGroupedMetricToCWMetric(groupedMetric *groupedMetric, config *Conf
 	}
 }
 
+// handleLabelValueNewlines replaces or strips newline characters in the label values of fields
+// according to the configured LabelValueNewlineHandling.
+func handleLabelValueNewlines(fields map[string]interface{}, labels map[string]string, cWMeasurements []cWMeasurement, config *Config) {
+	handling := config.LabelValueNewlineHandling
+	if handling.Mode != newlineHandlingStrip && handling.Mode != newlineHandlingReplace {
+		return
+	}
+
+	var dimensionKeys map[string]bool
+	if handling.RetainInFields {
+		dimensionKeys = make(map[string]bool)
+		for _, cwm := range cWMeasurements {
+			for _, dimSet := range cwm.Dimensions {
+				for _, dim := range dimSet {
+					dimensionKeys[dim] = true
+				}
+			}
+		}
+	}
+
+	replacement := ""
+	if handling.Mode == newlineHandlingReplace {
+		replacement = handling.Replacement
+	}
+	for k, v := range labels {
+		if !strings.ContainsAny(v, "\r\n") {
+			continue
+		}
+		if handling.RetainInFields && !dimensionKeys[k] {
+			continue
+		}
+		fields[k] = replaceNewlines(v, replacement)
+		config.logger.Debug(
+			"Modified label value containing newline characters",
+			zap.String("Label", k),
+			zap.String("Mode", handling.Mode),
+		)
+	}
+}
+
 // groupedMetricToCWMeasurement creates a single CW Measurement from a grouped metric.
 func groupedMetricToCWMeasurement(groupedMetric *groupedMetric, config *Config) cWMeasurement {
 	labels := groupedMetric.labels
@@ -214,15 +374,10 @@ func groupedMetricToCWMeasurement(groupedMetric *groupedMetric, config *Config)
 	// Add on rolled-up dimensions
 	dimensions = append(dimensions, rollupDimensionArray...)
 
-	metrics := make([]map[string]string, len(groupedMetric.metrics))
+	metrics := make([]map[string]interface{}, len(groupedMetric.metrics))
 	idx = 0
 	for metricName, metricInfo := range groupedMetric.metrics {
-		metrics[idx] = map[string]string{
-			"Name": metricName,
-		}
-		if metricInfo.unit != "" {
-			metrics[idx]["Unit"] = metricInfo.unit
-		}
+		metrics[idx] = metricInfo.toCWMetricDefinition(metricName)
 		idx++
 	}
 
@@ -233,6 +388,71 @@ func groupedMetricToCWMeasurement(groupedMetric *groupedMetric, config *Config)
 	}
 }
 
+// groupedMetricToCWMeasurements translates the grouped metric into a CW Measurement with the dimensions of its
+// labels, and a CW Measurement for each distinct list of dimension sets defined by the metric descriptors of its
+// metrics. Only the dimension sets whose dimensions are all labels of the grouped metric are used, metrics without
+// any such set are put in the CW Measurement with the dimensions of the labels.
+func groupedMetricToCWMeasurements(groupedMetric *groupedMetric, config *Config) []cWMeasurement {
+	labelMetrics := make(map[string]*metricInfo, len(groupedMetric.metrics))
+	descriptorDims := make(map[string][][]string)
+	descriptorMetrics := make(map[string][]string)
+	for metricName, metricInfo := range groupedMetric.metrics {
+		var dims [][]string
+		for _, dimSet := range metricInfo.dimensions {
+			if hasAllLabels(groupedMetric.labels, dimSet) {
+				dims = append(dims, dimSet)
+			}
+		}
+		if len(dims) == 0 {
+			labelMetrics[metricName] = metricInfo
+			continue
+		}
+		key := fmt.Sprint(dims)
+		descriptorDims[key] = dims
+		descriptorMetrics[key] = append(descriptorMetrics[key], metricName)
+	}
+
+	cWMeasurements := make([]cWMeasurement, 0, len(descriptorDims)+1)
+	if len(labelMetrics) == len(groupedMetric.metrics) {
+		return append(cWMeasurements, groupedMetricToCWMeasurement(groupedMetric, config))
+	}
+	if len(labelMetrics) > 0 {
+		labelGroup := *groupedMetric
+		labelGroup.metrics = labelMetrics
+		cWMeasurements = append(cWMeasurements, groupedMetricToCWMeasurement(&labelGroup, config))
+	}
+
+	keys := make([]string, 0, len(descriptorDims))
+	for key := range descriptorDims {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		metricNames := descriptorMetrics[key]
+		sort.Strings(metricNames)
+		metrics := make([]map[string]interface{}, len(metricNames))
+		for i, metricName := range metricNames {
+			metrics[i] = groupedMetric.metrics[metricName].toCWMetricDefinition(metricName)
+		}
+		cWMeasurements = append(cWMeasurements, cWMeasurement{
+			Namespace:  groupedMetric.metadata.namespace,
+			Dimensions: descriptorDims[key],
+			Metrics:    metrics,
+		})
+	}
+	return cWMeasurements
+}
+
+// hasAllLabels returns true if all the dimensions are keys of the labels.
+func hasAllLabels(labels map[string]string, dimensions []string) bool {
+	for _, dim := range dimensions {
+		if _, ok := labels[dim]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // groupedMetricToCWMeasurementsWithFilters filters the grouped metric using the given list of metric
 // declarations and returns the corresponding list of CW Measurements.
 func groupedMetricToCWMeasurementsWithFilters(groupedMetric *groupedMetric, config *Config) (cWMeasurements []cWMeasurement) {
@@ -264,7 +484,7 @@ func groupedMetricToCWMeasurementsWithFilters(groupedMetric *groupedMetric, conf
 	// Group metrics by matched metric declarations
 	type metricDeclarationGroup struct {
 		metricDeclIdxList []int
-		metrics           []map[string]string
+		metrics           []map[string]interface{}
 	}
 
 	metricDeclGroups := make(map[string]*metricDeclarationGroup)
@@ -285,19 +505,14 @@ func groupedMetricToCWMeasurementsWithFilters(groupedMetric *groupedMetric, conf
 			continue
 		}
 
-		metric := map[string]string{
-			"Name": metricName,
-		}
-		if metricInfo.unit != "" {
-			metric["Unit"] = metricInfo.unit
-		}
+		metric := metricInfo.toCWMetricDefinition(metricName)
 		metricDeclKey := fmt.Sprint(metricDeclIdx)
 		if group, ok := metricDeclGroups[metricDeclKey]; ok {
 			group.metrics = append(group.metrics, metric)
 		} else {
 			metricDeclGroups[metricDeclKey] = &metricDeclarationGroup{
 				metricDeclIdxList: metricDeclIdx,
-				metrics:           []map[string]string{metric},
+				metrics:           []map[string]interface{}{metric},
 			}
 		}
 	}
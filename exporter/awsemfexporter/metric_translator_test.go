@@ -15,10 +15,12 @@
 package awsemfexporter
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -29,6 +31,7 @@ import (
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
@@ -340,11 +343,11 @@ func normalizeDimensionality(dims [][]string) [][]string {
 }
 
 // hashMetricSlice hashes a metrics slice for equality checking.
-func hashMetricSlice(metricSlice []map[string]string) []string {
+func hashMetricSlice(metricSlice []map[string]interface{}) []string {
 	// Convert to string for easier sorting
 	stringified := make([]string, len(metricSlice))
 	for i, v := range metricSlice {
-		stringified[i] = v["Name"] + "," + v["Unit"]
+		stringified[i] = fmt.Sprint(v["Name"], ",", v["Unit"])
 	}
 	// Sort across metrics for equality checking
 	sort.Strings(stringified)
@@ -448,7 +451,7 @@ func TestTranslateOtToGroupedMetric(t *testing.T) {
 
 	counterSumMetrics := map[string]*metricInfo{
 		"spanCounter": {
-			value: float64(1),
+			value: int64(1),
 			unit:  "Count",
 		},
 		"spanDoubleCounter": {
@@ -458,7 +461,7 @@ func TestTranslateOtToGroupedMetric(t *testing.T) {
 	}
 	counterGaugeMetrics := map[string]*metricInfo{
 		"spanGaugeCounter": {
-			value: float64(1),
+			value: int64(1),
 			unit:  "Count",
 		},
 		"spanGaugeDoubleCounter": {
@@ -469,6 +472,7 @@ func TestTranslateOtToGroupedMetric(t *testing.T) {
 	timerMetrics := map[string]*metricInfo{
 		"spanTimer": {
 			value: &cWMetricStats{
+				Max:   10,
 				Count: 5,
 				Sum:   15,
 			},
@@ -575,11 +579,739 @@ func TestTranslateOtToGroupedMetric(t *testing.T) {
 	})
 }
 
+func TestTranslateOtToGroupedMetricWithResourceIdentity(t *testing.T) {
+	md := generateTestMetrics(testMetric{
+		metricNames:  []string{"metric_1", "metric_2"},
+		metricValues: [][]float64{{100}, {4}},
+		resourceAttributeMap: map[string]interface{}{
+			"host.name": "host-1",
+		},
+		attributeMap: map[string]interface{}{
+			"label1": "value1",
+		},
+	})
+	// Add the same metrics with identical labels reported by another host
+	rm2 := md.ResourceMetrics().AppendEmpty()
+	md.ResourceMetrics().At(0).CopyTo(rm2)
+	rm2.Resource().Attributes().PutStr("host.name", "host-2")
+
+	testCases := []struct {
+		name                      string
+		resourceIdentityAttribute string
+		expectedGroups            int
+	}{
+		{
+			name:                      "disabled",
+			resourceIdentityAttribute: "",
+			expectedGroups:            1,
+		},
+		{
+			name:                      "enabled",
+			resourceIdentityAttribute: "host.name",
+			expectedGroups:            2,
+		},
+		{
+			name:                      "missing attribute",
+			resourceIdentityAttribute: "host.id",
+			expectedGroups:            1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := &Config{
+				DimensionRollupOption:     zeroAndSingleDimensionRollup,
+				ResourceIdentityAttribute: tc.resourceIdentityAttribute,
+				logger:                    zap.NewNop(),
+			}
+			translator := newMetricTranslator(*config)
+
+			groupedMetrics := make(map[interface{}]*groupedMetric)
+			for i := 0; i < md.ResourceMetrics().Len(); i++ {
+				err := translator.translateOTelToGroupedMetric(md.ResourceMetrics().At(i), groupedMetrics, config)
+				assert.Nil(t, err)
+			}
+
+			assert.Equal(t, tc.expectedGroups, len(groupedMetrics))
+			for _, group := range groupedMetrics {
+				assert.Equal(t, map[string]string{"label1": "value1"}, group.labels)
+				assert.Equal(t, 2, len(group.metrics))
+			}
+		})
+	}
+}
+
+func TestTranslateOtToGroupedMetricWithTemporality(t *testing.T) {
+	generateMetrics := func(value int64) pmetric.ResourceMetrics {
+		rm := pmetric.NewResourceMetrics()
+		metrics := rm.ScopeMetrics().AppendEmpty().Metrics()
+
+		deltaSum := metrics.AppendEmpty()
+		deltaSum.SetName("temporality_delta_sum")
+		deltaSum.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		dp := deltaSum.Sum().DataPoints().AppendEmpty()
+		dp.SetIntValue(value)
+		dp.Attributes().PutStr("label1", "value1")
+
+		cumulativeSum := metrics.AppendEmpty()
+		cumulativeSum.SetName("temporality_cumulative_sum")
+		cumulativeSum.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		cumulativeSum.Sum().SetIsMonotonic(true)
+		dp = cumulativeSum.Sum().DataPoints().AppendEmpty()
+		dp.SetIntValue(value)
+		dp.SetTimestamp(pcommon.Timestamp(value * int64(time.Second)))
+		dp.Attributes().PutStr("label1", "value1")
+
+		gauge := metrics.AppendEmpty()
+		gauge.SetName("temporality_gauge")
+		dp = gauge.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.SetIntValue(value)
+		dp.Attributes().PutStr("label1", "value1")
+		return rm
+	}
+
+	testCases := []struct {
+		name                string
+		emitTemporality     bool
+		expectedTemporality map[string]interface{}
+	}{
+		{
+			name:            "disabled",
+			emitTemporality: false,
+			expectedTemporality: map[string]interface{}{
+				"temporality_delta_sum":      nil,
+				"temporality_cumulative_sum": nil,
+				"temporality_gauge":          nil,
+			},
+		},
+		{
+			name:            "enabled",
+			emitTemporality: true,
+			expectedTemporality: map[string]interface{}{
+				"temporality_delta_sum":      "delta",
+				"temporality_cumulative_sum": "cumulative",
+				"temporality_gauge":          nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := &Config{
+				DimensionRollupOption: "",
+				EmitTemporality:       tc.emitTemporality,
+				logger:                zap.NewNop(),
+			}
+			translator := newMetricTranslator(*config)
+
+			// The first cumulative data point is only used as the baseline of the delta calculation
+			err := translator.translateOTelToGroupedMetric(generateMetrics(10), make(map[interface{}]*groupedMetric), config)
+			assert.NoError(t, err)
+			groupedMetrics := make(map[interface{}]*groupedMetric)
+			err = translator.translateOTelToGroupedMetric(generateMetrics(25), groupedMetrics, config)
+			assert.NoError(t, err)
+
+			temporalities := make(map[string]interface{})
+			for _, group := range groupedMetrics {
+				cWMetric := translateGroupedMetricToCWMetric(group, config)
+				for metricName := range group.metrics {
+					temporalities[metricName] = cWMetric.fields[fieldTemporality]
+				}
+				if tc.emitTemporality {
+					assert.Equal(t, 1, len(group.metrics))
+				}
+			}
+			assert.Equal(t, tc.expectedTemporality, temporalities)
+		})
+	}
+}
+
+func TestTranslateOtToGroupedMetricWithScope(t *testing.T) {
+	rm := pmetric.NewResourceMetrics()
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp")
+	sm.Scope().SetVersion("0.37.0")
+	gauge := sm.Metrics().AppendEmpty()
+	gauge.SetName("scope_gauge")
+	gauge.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(1)
+	sm = rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("unversioned")
+	gauge = sm.Metrics().AppendEmpty()
+	gauge.SetName("unversioned_gauge")
+	gauge.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(2)
+	gauge = rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	gauge.SetName("no_scope_gauge")
+	gauge.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(3)
+
+	type scopeFields struct {
+		name    interface{}
+		version interface{}
+	}
+	testCases := []struct {
+		name           string
+		includeScope   bool
+		expectedScopes map[string]scopeFields
+	}{
+		{
+			name:         "disabled",
+			includeScope: false,
+			expectedScopes: map[string]scopeFields{
+				"scope_gauge":       {},
+				"unversioned_gauge": {},
+				"no_scope_gauge":    {},
+			},
+		},
+		{
+			name:         "enabled",
+			includeScope: true,
+			expectedScopes: map[string]scopeFields{
+				"scope_gauge": {
+					name:    "go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp",
+					version: "0.37.0",
+				},
+				"unversioned_gauge": {
+					name: "unversioned",
+				},
+				"no_scope_gauge": {},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := &Config{
+				DimensionRollupOption: "",
+				IncludeScope:          tc.includeScope,
+				logger:                zap.NewNop(),
+			}
+			translator := newMetricTranslator(*config)
+			groupedMetrics := make(map[interface{}]*groupedMetric)
+			err := translator.translateOTelToGroupedMetric(rm, groupedMetrics, config)
+			require.NoError(t, err)
+
+			scopes := make(map[string]scopeFields)
+			for _, group := range groupedMetrics {
+				cWMetric := translateGroupedMetricToCWMetric(group, config)
+				for metricName := range group.metrics {
+					scopes[metricName] = scopeFields{
+						name:    cWMetric.fields[fieldScopeName],
+						version: cWMetric.fields[fieldScopeVersion],
+					}
+				}
+			}
+			assert.Equal(t, tc.expectedScopes, scopes)
+		})
+	}
+}
+
+func TestTranslateOtToGroupedMetricWithResourceAttributesAsDimensions(t *testing.T) {
+	generateMetrics := func(service string) pmetric.ResourceMetrics {
+		rm := pmetric.NewResourceMetrics()
+		rm.Resource().Attributes().PutStr("service.name", service)
+		rm.Resource().Attributes().PutStr("region", "us-west-2")
+		rm.Resource().Attributes().PutStr("host.name", "host-1")
+		metric := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		metric.SetName("queue_size")
+		dps := metric.SetEmptyGauge().DataPoints()
+		dp := dps.AppendEmpty()
+		dp.SetTimestamp(pcommon.Timestamp(100 * time.Second))
+		dp.SetIntValue(1)
+		dp.Attributes().PutStr("queue", "orders")
+		// The label takes precedence over the resource attribute with the same name
+		dp = dps.AppendEmpty()
+		dp.SetTimestamp(pcommon.Timestamp(100 * time.Second))
+		dp.SetIntValue(2)
+		dp.Attributes().PutStr("queue", "orders")
+		dp.Attributes().PutStr("region", "eu-west-1")
+		return rm
+	}
+
+	testCases := []struct {
+		name               string
+		resourceAttributes []string
+		expectedLabels     []map[string]string
+	}{
+		{
+			name: "not set",
+			expectedLabels: []map[string]string{
+				{"queue": "orders"},
+				{"queue": "orders", "region": "eu-west-1"},
+			},
+		},
+		{
+			name:               "merged with labels",
+			resourceAttributes: []string{"service.name", "region", "missing"},
+			expectedLabels: []map[string]string{
+				{"queue": "orders", "service.name": "checkout", "region": "us-west-2"},
+				{"queue": "orders", "service.name": "checkout", "region": "eu-west-1"},
+				{"queue": "orders", "service.name": "payment", "region": "us-west-2"},
+				{"queue": "orders", "service.name": "payment", "region": "eu-west-1"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := &Config{
+				Namespace:                      "test-namespace",
+				ResourceAttributesAsDimensions: tc.resourceAttributes,
+				logger:                         zap.NewNop(),
+			}
+			translator := newMetricTranslator(*config)
+			groupedMetrics := make(map[interface{}]*groupedMetric)
+			for _, service := range []string{"checkout", "payment"} {
+				require.NoError(t, translator.translateOTelToGroupedMetric(generateMetrics(service), groupedMetrics, config))
+			}
+
+			var labels []map[string]string
+			for _, group := range groupedMetrics {
+				labels = append(labels, group.labels)
+
+				// The resource attributes are emitted as dimensions like the labels
+				cWMetric := translateGroupedMetricToCWMetric(group, config)
+				require.Equal(t, 1, len(cWMetric.measurements))
+				require.Equal(t, 1, len(cWMetric.measurements[0].Dimensions))
+				assert.Equal(t, len(group.labels), len(cWMetric.measurements[0].Dimensions[0]))
+				for k, v := range group.labels {
+					assert.Contains(t, cWMetric.measurements[0].Dimensions[0], k)
+					assert.Equal(t, v, cWMetric.fields[k])
+				}
+			}
+			assert.ElementsMatch(t, tc.expectedLabels, labels)
+		})
+	}
+}
+
+func TestTranslateOtToGroupedMetricWithStorageResolution(t *testing.T) {
+	md := generateTestMetrics(testMetric{
+		metricNames:  []string{"metric_1", "metric_2", "metric_3"},
+		metricValues: [][]float64{{100}, {4}, {1}},
+		attributeMap: map[string]interface{}{
+			"label1": "value1",
+		},
+	})
+
+	testCases := []struct {
+		name                string
+		storageResolution   int64
+		descriptors         []MetricDescriptor
+		expectedResolutions map[string]interface{}
+	}{
+		{
+			name: "not set",
+			expectedResolutions: map[string]interface{}{
+				"metric_1": nil,
+				"metric_2": nil,
+				"metric_3": nil,
+			},
+		},
+		{
+			name:              "high resolution",
+			storageResolution: 1,
+			expectedResolutions: map[string]interface{}{
+				"metric_1": int64(1),
+				"metric_2": int64(1),
+				"metric_3": int64(1),
+			},
+		},
+		{
+			name: "descriptor override",
+			descriptors: []MetricDescriptor{
+				{MetricName: "metric_2", StorageResolution: 1},
+			},
+			expectedResolutions: map[string]interface{}{
+				"metric_1": nil,
+				"metric_2": int64(1),
+				"metric_3": nil,
+			},
+		},
+		{
+			name:              "descriptor override of high resolution",
+			storageResolution: 1,
+			descriptors: []MetricDescriptor{
+				{MetricName: "metric_2", StorageResolution: 60},
+				{MetricName: "metric_3", Unit: "Count"},
+			},
+			expectedResolutions: map[string]interface{}{
+				"metric_1": int64(1),
+				"metric_2": int64(60),
+				"metric_3": int64(1),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := &Config{
+				DimensionRollupOption: "",
+				StorageResolution:     tc.storageResolution,
+				MetricDescriptors:     tc.descriptors,
+				logger:                zap.NewNop(),
+			}
+			assert.NoError(t, config.Validate())
+			translator := newMetricTranslator(*config)
+
+			groupedMetrics := make(map[interface{}]*groupedMetric)
+			err := translator.translateOTelToGroupedMetric(md.ResourceMetrics().At(0), groupedMetrics, config)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, len(groupedMetrics))
+
+			resolutions := make(map[string]interface{})
+			for _, group := range groupedMetrics {
+				cWMetric := translateGroupedMetricToCWMetric(group, config)
+				assert.Equal(t, 1, len(cWMetric.measurements))
+				for _, metric := range cWMetric.measurements[0].Metrics {
+					resolutions[metric["Name"].(string)] = metric["StorageResolution"]
+				}
+			}
+			assert.Equal(t, tc.expectedResolutions, resolutions)
+		})
+	}
+}
+
+func TestTranslateOtToEMFWithLargeIntValues(t *testing.T) {
+	setupDataPointCache()
+
+	generateMetrics := func(counterValue int64) pmetric.ResourceMetrics {
+		rm := pmetric.NewResourceMetrics()
+		metrics := rm.ScopeMetrics().AppendEmpty().Metrics()
+
+		gauge := metrics.AppendEmpty()
+		gauge.SetName("bytes_gauge")
+		dp := gauge.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.SetIntValue(1<<53 + 1)
+
+		counter := metrics.AppendEmpty()
+		counter.SetName("bytes_counter")
+		counter.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		counter.Sum().SetIsMonotonic(true)
+		dp = counter.Sum().DataPoints().AppendEmpty()
+		dp.SetIntValue(counterValue)
+
+		deltaCounter := metrics.AppendEmpty()
+		deltaCounter.SetName("bytes_delta_counter")
+		deltaCounter.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		deltaCounter.Sum().SetIsMonotonic(true)
+		dp = deltaCounter.Sum().DataPoints().AppendEmpty()
+		dp.SetIntValue(counterValue)
+		return rm
+	}
+
+	config := &Config{
+		DimensionRollupOption: "",
+		logger:                zap.NewNop(),
+	}
+	translator := newMetricTranslator(*config)
+
+	// The first cumulative data point is only used as the baseline of the delta calculation
+	err := translator.translateOTelToGroupedMetric(generateMetrics(1<<60), make(map[interface{}]*groupedMetric), config)
+	assert.NoError(t, err)
+	groupedMetrics := make(map[interface{}]*groupedMetric)
+	err = translator.translateOTelToGroupedMetric(generateMetrics(1<<60+1<<53+1), groupedMetrics, config)
+	assert.NoError(t, err)
+
+	values := make(map[string]interface{})
+	for _, group := range groupedMetrics {
+		event := translateCWMetricToEMF(translateGroupedMetricToCWMetric(group, config), config)
+		decoder := json.NewDecoder(strings.NewReader(*event.InputLogEvent.Message))
+		decoder.UseNumber()
+		var emf map[string]interface{}
+		assert.NoError(t, decoder.Decode(&emf))
+		for name := range group.metrics {
+			values[name] = emf[name]
+		}
+	}
+	assert.Equal(t, map[string]interface{}{
+		"bytes_gauge":         json.Number("9007199254740993"),
+		"bytes_counter":       json.Number("9007199254740993"),
+		"bytes_delta_counter": json.Number("1161928703861587969"),
+	}, values)
+}
+
+func TestTranslateOtToGroupedMetricWithDescriptorNamespace(t *testing.T) {
+	md := generateTestMetrics(testMetric{
+		metricNames:  []string{"metric_1", "metric_2", "metric_3"},
+		metricValues: [][]float64{{100}, {4}, {1}},
+		attributeMap: map[string]interface{}{
+			"label1": "value1",
+		},
+	})
+	config := &Config{
+		Namespace:             "default-namespace",
+		DimensionRollupOption: "",
+		MetricDescriptors: []MetricDescriptor{
+			{MetricName: "metric_2", Namespace: "custom-namespace"},
+			{MetricName: "metric_3", Unit: "Count"},
+		},
+		logger: zap.NewNop(),
+	}
+	assert.NoError(t, config.Validate())
+	assert.Equal(t, 2, len(config.MetricDescriptors))
+	translator := newMetricTranslator(*config)
+
+	groupedMetrics := make(map[interface{}]*groupedMetric)
+	err := translator.translateOTelToGroupedMetric(md.ResourceMetrics().At(0), groupedMetrics, config)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(groupedMetrics))
+
+	namespaces := make(map[string][]string)
+	for _, group := range groupedMetrics {
+		cWMetric := translateGroupedMetricToCWMetric(group, config)
+		assert.Equal(t, 1, len(cWMetric.measurements))
+		measurement := cWMetric.measurements[0]
+		for _, metric := range measurement.Metrics {
+			namespaces[measurement.Namespace] = append(namespaces[measurement.Namespace], metric["Name"].(string))
+		}
+		assert.Equal(t, map[string]string{"label1": "value1"}, group.labels)
+	}
+	for _, names := range namespaces {
+		sort.Strings(names)
+	}
+	assert.Equal(t, map[string][]string{
+		"default-namespace": {"metric_1", "metric_3"},
+		"custom-namespace":  {"metric_2"},
+	}, namespaces)
+}
+
+func TestTranslateCWMetricToEMFWithoutDimensions(t *testing.T) {
+	md := pmetric.NewMetrics()
+	metric := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	metric.SetName("queueSize")
+	dp := metric.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.Timestamp(100 * time.Second))
+	dp.SetIntValue(7)
+	dp.Attributes().PutStr("queue", "orders")
+
+	testCases := []struct {
+		name               string
+		metricDeclarations []*MetricDeclaration
+	}{
+		{
+			"without metric declarations",
+			nil,
+		},
+		{
+			"with empty dimension set in metric declarations",
+			[]*MetricDeclaration{{Dimensions: [][]string{{}}, MetricNameSelectors: []string{"queueSize"}}},
+		},
+		{
+			"with nil dimension set in metric declarations",
+			[]*MetricDeclaration{{Dimensions: [][]string{nil}, MetricNameSelectors: []string{"queueSize"}}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := &Config{
+				Namespace:          "test-namespace",
+				ExcludeDimensions:  []string{"queue", oTellibDimensionKey},
+				MetricDeclarations: tc.metricDeclarations,
+				logger:             zap.NewNop(),
+			}
+			for _, decl := range config.MetricDeclarations {
+				require.NoError(t, decl.init(config.logger))
+			}
+
+			groupedMetrics := make(map[interface{}]*groupedMetric)
+			require.NoError(t, newMetricTranslator(*config).translateOTelToGroupedMetric(md.ResourceMetrics().At(0), groupedMetrics, config))
+			require.Equal(t, 1, len(groupedMetrics))
+			for _, group := range groupedMetrics {
+				assert.Empty(t, group.labels)
+				event := translateCWMetricToEMF(translateGroupedMetricToCWMetric(group, config), config)
+				require.NotNil(t, event)
+				assert.JSONEq(t, `{
+					"_aws": {
+						"CloudWatchMetrics": [{"Namespace": "test-namespace", "Dimensions": [[]], "Metrics": [{"Name": "queueSize"}]}],
+						"Timestamp": 100000
+					},
+					"queueSize": 7
+				}`, *event.InputLogEvent.Message)
+			}
+		})
+	}
+}
+
+func TestTranslateCWMetricToEMFWithUnitField(t *testing.T) {
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	for name, unit := range map[string]string{"latency": "ms", "cpu": "", "queue_size": ""} {
+		metric := metrics.AppendEmpty()
+		metric.SetName(name)
+		metric.SetUnit(unit)
+		dp := metric.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.SetTimestamp(pcommon.Timestamp(100 * time.Second))
+		dp.SetIntValue(7)
+	}
+
+	testCases := []struct {
+		name              string
+		emitUnitAsField   bool
+		preserveUCUMUnits bool
+		expectedUnits     map[string]interface{}
+	}{
+		{
+			name: "disabled",
+			expectedUnits: map[string]interface{}{
+				"latency.unit":    nil,
+				"cpu.unit":        nil,
+				"queue_size.unit": nil,
+			},
+		},
+		{
+			name:            "enabled",
+			emitUnitAsField: true,
+			expectedUnits: map[string]interface{}{
+				"latency.unit":    "Milliseconds",
+				"cpu.unit":        "Percent",
+				"queue_size.unit": nil,
+			},
+		},
+		{
+			name:              "enabled with preserved UCUM units",
+			emitUnitAsField:   true,
+			preserveUCUMUnits: true,
+			expectedUnits: map[string]interface{}{
+				"latency.unit":    "ms",
+				"cpu.unit":        "Percent",
+				"queue_size.unit": nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := &Config{
+				Namespace:         "test-namespace",
+				EmitUnitAsField:   tc.emitUnitAsField,
+				PreserveUCUMUnits: tc.preserveUCUMUnits,
+				MetricDescriptors: []MetricDescriptor{{MetricName: "cpu", Unit: "Percent"}},
+				logger:            zap.NewNop(),
+			}
+			groupedMetrics := make(map[interface{}]*groupedMetric)
+			require.NoError(t, newMetricTranslator(*config).translateOTelToGroupedMetric(md.ResourceMetrics().At(0), groupedMetrics, config))
+			require.Equal(t, 1, len(groupedMetrics))
+			for _, group := range groupedMetrics {
+				event := translateCWMetricToEMF(translateGroupedMetricToCWMetric(group, config), config)
+				require.NotNil(t, event)
+
+				var fields map[string]interface{}
+				require.NoError(t, json.Unmarshal([]byte(*event.InputLogEvent.Message), &fields))
+				units := make(map[string]interface{})
+				for field := range tc.expectedUnits {
+					units[field] = fields[field]
+				}
+				assert.Equal(t, tc.expectedUnits, units)
+				// The unit field is emitted in addition to the value and the unit of the metric directive
+				assert.Equal(t, 7.0, fields["latency"])
+				directive := fields["_aws"].(map[string]interface{})["CloudWatchMetrics"].([]interface{})[0].(map[string]interface{})
+				for _, definition := range directive["Metrics"].([]interface{}) {
+					definition := definition.(map[string]interface{})
+					if unit := fields[definition["Name"].(string)+fieldUnitSuffix]; unit != nil {
+						assert.Equal(t, definition["Unit"], unit)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestTranslateCWMetricToEMFWithDescriptorDimensions(t *testing.T) {
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	for _, name := range []string{"requests", "errors"} {
+		metric := metrics.AppendEmpty()
+		metric.SetName(name)
+		dp := metric.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.SetTimestamp(pcommon.Timestamp(100 * time.Second))
+		dp.SetIntValue(7)
+		dp.Attributes().PutStr("service", "checkout")
+		dp.Attributes().PutStr("instance", "i-1")
+	}
+
+	config := &Config{
+		Namespace:             "test-namespace",
+		DimensionRollupOption: zeroAndSingleDimensionRollup,
+		MetricDescriptors: []MetricDescriptor{{
+			MetricName: "requests",
+			// The region set is skipped as the metric has no region label
+			Dimensions: [][]string{{"service"}, {"instance", "service"}, {"region", "service"}},
+		}},
+		logger: zap.NewNop(),
+	}
+	groupedMetrics := make(map[interface{}]*groupedMetric)
+	require.NoError(t, newMetricTranslator(*config).translateOTelToGroupedMetric(md.ResourceMetrics().At(0), groupedMetrics, config))
+	require.Equal(t, 1, len(groupedMetrics))
+	for _, group := range groupedMetrics {
+		cWMetric := translateGroupedMetricToCWMetric(group, config)
+		require.Equal(t, 2, len(cWMetric.measurements))
+
+		// The metric without dimension sets keeps the dimensions of its labels and the rollup
+		labelMeasurement := cWMetric.measurements[0]
+		assert.Equal(t, []map[string]interface{}{{"Name": "errors"}}, labelMeasurement.Metrics)
+		require.Equal(t, 4, len(labelMeasurement.Dimensions))
+		assert.ElementsMatch(t, []string{"service", "instance"}, labelMeasurement.Dimensions[0])
+
+		assert.Equal(t, cWMeasurement{
+			Namespace:  "test-namespace",
+			Dimensions: [][]string{{"service"}, {"instance", "service"}},
+			Metrics:    []map[string]interface{}{{"Name": "requests"}},
+		}, cWMetric.measurements[1])
+
+		event := translateCWMetricToEMF(&cWMetrics{
+			measurements: cWMetric.measurements[1:],
+			timestampMs:  cWMetric.timestampMs,
+			fields:       cWMetric.fields,
+		}, config)
+		require.NotNil(t, event)
+		assert.JSONEq(t, `{
+			"_aws": {
+				"CloudWatchMetrics": [{"Namespace": "test-namespace", "Dimensions": [["service"], ["instance", "service"]], "Metrics": [{"Name": "requests"}]}],
+				"Timestamp": 100000
+			},
+			"service": "checkout",
+			"instance": "i-1",
+			"requests": 7,
+			"errors": 7
+		}`, *event.InputLogEvent.Message)
+	}
+}
+
+func TestGroupedMetricToCWMeasurementsWithDescriptorDimensions(t *testing.T) {
+	group := &groupedMetric{
+		labels: map[string]string{"service": "checkout"},
+		metrics: map[string]*metricInfo{
+			"b_requests": {value: 1, dimensions: [][]string{{"service"}}},
+			"a_requests": {value: 2, dimensions: [][]string{{"service"}}},
+			"latency":    {value: 3, dimensions: [][]string{{}, {"service"}}},
+			"errors":     {value: 4, dimensions: [][]string{{"instance"}}},
+		},
+		metadata: cWMetricMetadata{groupedMetricMetadata: groupedMetricMetadata{namespace: "test-namespace"}},
+	}
+	config := &Config{logger: zap.NewNop()}
+
+	assert.Equal(t, []cWMeasurement{
+		{
+			Namespace:  "test-namespace",
+			Dimensions: [][]string{{"service"}},
+			Metrics:    []map[string]interface{}{{"Name": "errors"}},
+		},
+		{
+			Namespace:  "test-namespace",
+			Dimensions: [][]string{{}, {"service"}},
+			Metrics:    []map[string]interface{}{{"Name": "latency"}},
+		},
+		{
+			Namespace:  "test-namespace",
+			Dimensions: [][]string{{"service"}},
+			Metrics:    []map[string]interface{}{{"Name": "a_requests"}, {"Name": "b_requests"}},
+		},
+	}, groupedMetricToCWMeasurements(group, config))
+}
+
 func TestTranslateCWMetricToEMF(t *testing.T) {
 	cwMeasurement := cWMeasurement{
 		Namespace:  "test-emf",
 		Dimensions: [][]string{{oTellibDimensionKey}, {oTellibDimensionKey, "spanName"}},
-		Metrics: []map[string]string{{
+		Metrics: []map[string]interface{}{{
 			"Name": "spanCounter",
 			"Unit": "Count",
 		}},
@@ -643,7 +1375,7 @@ func TestTranslateGroupedMetricToCWMetric(t *testing.T) {
 					{
 						Namespace:  namespace,
 						Dimensions: [][]string{{"label1"}},
-						Metrics: []map[string]string{
+						Metrics: []map[string]interface{}{
 							{
 								"Name": "metric1",
 								"Unit": "Count",
@@ -688,7 +1420,7 @@ func TestTranslateGroupedMetricToCWMetric(t *testing.T) {
 					{
 						Namespace:  namespace,
 						Dimensions: [][]string{{"label1"}},
-						Metrics: []map[string]string{
+						Metrics: []map[string]interface{}{
 							{
 								"Name": "metric1",
 								"Unit": "Count",
@@ -737,7 +1469,7 @@ func TestTranslateGroupedMetricToCWMetric(t *testing.T) {
 					{
 						Namespace:  namespace,
 						Dimensions: [][]string{{"label1", "label2"}},
-						Metrics: []map[string]string{
+						Metrics: []map[string]interface{}{
 							{
 								"Name": "metric1",
 								"Unit": "Count",
@@ -812,7 +1544,7 @@ func TestTranslateGroupedMetricToCWMetric(t *testing.T) {
 					{
 						Namespace:  namespace,
 						Dimensions: [][]string{{"label1"}},
-						Metrics: []map[string]string{
+						Metrics: []map[string]interface{}{
 							{
 								"Name": "metric1",
 								"Unit": "Count",
@@ -822,7 +1554,7 @@ func TestTranslateGroupedMetricToCWMetric(t *testing.T) {
 					{
 						Namespace:  namespace,
 						Dimensions: [][]string{{"label1", "label2"}},
-						Metrics: []map[string]string{
+						Metrics: []map[string]interface{}{
 							{
 								"Name": "metric2",
 								"Unit": "Count",
@@ -869,6 +1601,62 @@ func TestTranslateGroupedMetricToCWMetric(t *testing.T) {
 				},
 			},
 		},
+		{
+			"metric with percentiles",
+			&groupedMetric{
+				labels: map[string]string{
+					"label1": "value1",
+				},
+				metrics: map[string]*metricInfo{
+					"metric1": {
+						value: &cWMetricStats{
+							Count: 4,
+							Sum:   10,
+							Min:   1,
+							Max:   4,
+						},
+						unit: "Milliseconds",
+						extraFields: map[string]interface{}{
+							"p50": float64(2),
+							"p99": 3.96,
+						},
+					},
+				},
+				metadata: cWMetricMetadata{
+					groupedMetricMetadata: groupedMetricMetadata{
+						namespace:   namespace,
+						timestampMs: timestamp,
+					},
+				},
+			},
+			nil,
+			&cWMetrics{
+				measurements: []cWMeasurement{
+					{
+						Namespace:  namespace,
+						Dimensions: [][]string{{"label1"}},
+						Metrics: []map[string]interface{}{
+							{
+								"Name": "metric1",
+								"Unit": "Milliseconds",
+							},
+						},
+					},
+				},
+				timestampMs: timestamp,
+				fields: map[string]interface{}{
+					"label1": "value1",
+					"metric1": &cWMetricStats{
+						Count: 4,
+						Sum:   10,
+						Min:   1,
+						Max:   4,
+					},
+					"metric1_p50": float64(2),
+					"metric1_p99": 3.96,
+				},
+			},
+		},
 		{
 			"prometheus metrics",
 			&groupedMetric{
@@ -896,7 +1684,7 @@ func TestTranslateGroupedMetricToCWMetric(t *testing.T) {
 					{
 						Namespace:  namespace,
 						Dimensions: [][]string{{"label1"}},
-						Metrics: []map[string]string{
+						Metrics: []map[string]interface{}{
 							{
 								"Name": "metric1",
 								"Unit": "Count",
@@ -934,6 +1722,125 @@ func TestTranslateGroupedMetricToCWMetric(t *testing.T) {
 	}
 }
 
+func TestTranslateGroupedMetricToCWMetricWithNewlineHandling(t *testing.T) {
+	timestamp := int64(1596151098037)
+	namespace := "Namespace"
+	testCases := []struct {
+		testName           string
+		handling           LabelValueNewlineHandling
+		metricDeclarations []*MetricDeclaration
+		expectedFields     map[string]interface{}
+	}{
+		{
+			"none mode",
+			LabelValueNewlineHandling{Mode: newlineHandlingNone},
+			nil,
+			map[string]interface{}{
+				"label1":  "value1\nvalue2",
+				"label2":  "value3\r\nvalue4",
+				"metric1": 1,
+			},
+		},
+		{
+			"strip mode",
+			LabelValueNewlineHandling{Mode: newlineHandlingStrip},
+			nil,
+			map[string]interface{}{
+				"label1":  "value1value2",
+				"label2":  "value3value4",
+				"metric1": 1,
+			},
+		},
+		{
+			"replace mode",
+			LabelValueNewlineHandling{Mode: newlineHandlingReplace, Replacement: " "},
+			nil,
+			map[string]interface{}{
+				"label1":  "value1 value2",
+				"label2":  "value3 value4",
+				"metric1": 1,
+			},
+		},
+		{
+			"replace mode retaining newlines in fields",
+			LabelValueNewlineHandling{Mode: newlineHandlingReplace, Replacement: "\\n", RetainInFields: true},
+			[]*MetricDeclaration{
+				{
+					Dimensions:          [][]string{{"label1"}},
+					MetricNameSelectors: []string{"metric1"},
+				},
+			},
+			map[string]interface{}{
+				"label1":  "value1\\nvalue2",
+				"label2":  "value3\r\nvalue4",
+				"metric1": 1,
+			},
+		},
+		{
+			"strip mode not retaining newlines in fields",
+			LabelValueNewlineHandling{Mode: newlineHandlingStrip},
+			[]*MetricDeclaration{
+				{
+					Dimensions:          [][]string{{"label1"}},
+					MetricNameSelectors: []string{"metric1"},
+				},
+			},
+			map[string]interface{}{
+				"label1":  "value1value2",
+				"label2":  "value3value4",
+				"metric1": 1,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			obs, logs := observer.New(zap.DebugLevel)
+			logger := zap.New(obs)
+			config := &Config{
+				MetricDeclarations:        tc.metricDeclarations,
+				DimensionRollupOption:     "",
+				LabelValueNewlineHandling: tc.handling,
+				logger:                    logger,
+			}
+			for _, decl := range tc.metricDeclarations {
+				err := decl.init(logger)
+				assert.Nil(t, err)
+			}
+			groupedMetric := &groupedMetric{
+				labels: map[string]string{
+					"label1": "value1\nvalue2",
+					"label2": "value3\r\nvalue4",
+				},
+				metrics: map[string]*metricInfo{
+					"metric1": {
+						value: 1,
+						unit:  "Count",
+					},
+				},
+				metadata: cWMetricMetadata{
+					groupedMetricMetadata: groupedMetricMetadata{
+						namespace:   namespace,
+						timestampMs: timestamp,
+					},
+				},
+			}
+
+			cWMetric := translateGroupedMetricToCWMetric(groupedMetric, config)
+			assert.NotNil(t, cWMetric)
+			assert.Equal(t, tc.expectedFields, cWMetric.fields)
+
+			modified := 0
+			for k, v := range groupedMetric.labels {
+				if cWMetric.fields[k] != v {
+					modified++
+				}
+			}
+			assert.Equal(t, modified, logs.FilterMessage("Modified label value containing newline characters").Len())
+		})
+	}
+}
+
 func TestGroupedMetricToCWMeasurement(t *testing.T) {
 	timestamp := int64(1596151098037)
 	namespace := "Namespace"
@@ -966,7 +1873,7 @@ func TestGroupedMetricToCWMeasurement(t *testing.T) {
 			cWMeasurement{
 				Namespace:  namespace,
 				Dimensions: [][]string{{"label1"}},
-				Metrics: []map[string]string{
+				Metrics: []map[string]interface{}{
 					{
 						"Name": "metric1",
 						"Unit": "Count",
@@ -1006,7 +1913,7 @@ func TestGroupedMetricToCWMeasurement(t *testing.T) {
 			cWMeasurement{
 				Namespace:  namespace,
 				Dimensions: [][]string{{"label1", "label2"}},
-				Metrics: []map[string]string{
+				Metrics: []map[string]interface{}{
 					{
 						"Name": "metric1",
 						"Unit": "Count",
@@ -1045,7 +1952,7 @@ func TestGroupedMetricToCWMeasurement(t *testing.T) {
 			cWMeasurement{
 				Namespace:  namespace,
 				Dimensions: [][]string{{"label1"}},
-				Metrics: []map[string]string{
+				Metrics: []map[string]interface{}{
 					{
 						"Name": "metric1",
 						"Unit": "Count",
@@ -1090,7 +1997,7 @@ func TestGroupedMetricToCWMeasurement(t *testing.T) {
 					{"label2"},
 					{},
 				},
-				Metrics: []map[string]string{
+				Metrics: []map[string]interface{}{
 					{
 						"Name": "metric1",
 						"Unit": "Count",
@@ -1333,7 +2240,7 @@ func TestGroupedMetricToCWMeasurementsWithFilters(t *testing.T) {
 				{
 					Namespace:  namespace,
 					Dimensions: [][]string{{"a"}, {"a", "c"}},
-					Metrics: []map[string]string{
+					Metrics: []map[string]interface{}{
 						{
 							"Name": "metric1",
 							"Unit": "Count",
@@ -1370,7 +2277,7 @@ func TestGroupedMetricToCWMeasurementsWithFilters(t *testing.T) {
 				{
 					Namespace:  namespace,
 					Dimensions: [][]string{{"a"}, {"b"}, {"a", "c"}},
-					Metrics: []map[string]string{
+					Metrics: []map[string]interface{}{
 						{
 							"Name": "metric1",
 							"Unit": "Count",
@@ -1380,7 +2287,7 @@ func TestGroupedMetricToCWMeasurementsWithFilters(t *testing.T) {
 				{
 					Namespace:  namespace,
 					Dimensions: [][]string{{"a"}, {"b"}},
-					Metrics: []map[string]string{
+					Metrics: []map[string]interface{}{
 						{
 							"Name": "metric2",
 							"Unit": "Count",
@@ -1390,7 +2297,7 @@ func TestGroupedMetricToCWMeasurementsWithFilters(t *testing.T) {
 				{
 					Namespace:  namespace,
 					Dimensions: [][]string{{"a"}},
-					Metrics: []map[string]string{
+					Metrics: []map[string]interface{}{
 						{
 							"Name": "metric3",
 							"Unit": "Seconds",
@@ -1415,7 +2322,7 @@ func TestGroupedMetricToCWMeasurementsWithFilters(t *testing.T) {
 				{
 					Namespace:  namespace,
 					Dimensions: [][]string{{"a"}, {"b"}},
-					Metrics: []map[string]string{
+					Metrics: []map[string]interface{}{
 						{
 							"Name": "metric1",
 							"Unit": "Count",
@@ -1429,7 +2336,7 @@ func TestGroupedMetricToCWMeasurementsWithFilters(t *testing.T) {
 				{
 					Namespace:  namespace,
 					Dimensions: [][]string{{"a"}},
-					Metrics: []map[string]string{
+					Metrics: []map[string]interface{}{
 						{
 							"Name": "metric3",
 							"Unit": "Seconds",
@@ -1454,7 +2361,7 @@ func TestGroupedMetricToCWMeasurementsWithFilters(t *testing.T) {
 				{
 					Namespace:  namespace,
 					Dimensions: [][]string{{"a"}, {"b"}},
-					Metrics: []map[string]string{
+					Metrics: []map[string]interface{}{
 						{
 							"Name": "metric1",
 							"Unit": "Count",
@@ -1488,7 +2395,7 @@ func TestGroupedMetricToCWMeasurementsWithFilters(t *testing.T) {
 				{
 					Namespace:  namespace,
 					Dimensions: [][]string{{}},
-					Metrics: []map[string]string{
+					Metrics: []map[string]interface{}{
 						{
 							"Name": "metric1",
 							"Unit": "Count",
@@ -1529,7 +2436,7 @@ func TestGroupedMetricToCWMeasurementsWithFilters(t *testing.T) {
 				{
 					Namespace:  namespace,
 					Dimensions: [][]string{{"b"}},
-					Metrics: []map[string]string{
+					Metrics: []map[string]interface{}{
 						{
 							"Name": "metric1",
 							"Unit": "Count",
@@ -2321,7 +3228,7 @@ func BenchmarkTranslateCWMetricToEMF(b *testing.B) {
 	cwMeasurement := cWMeasurement{
 		Namespace:  "test-emf",
 		Dimensions: [][]string{{oTellibDimensionKey}, {oTellibDimensionKey, "spanName"}},
-		Metrics: []map[string]string{{
+		Metrics: []map[string]interface{}{{
 			"Name": "spanCounter",
 			"Unit": "Count",
 		}},
@@ -2475,10 +3382,39 @@ var (
 			outLogGroupName:  "test-log-group-undefined",
 			outLogStreamName: "test-log-stream-test-pod",
 		},
+		{
+			name: "config_pattern_from_env",
+			inputMetrics: generateTestMetrics(testMetric{
+				metricNames:  []string{"metric_1", "metric_2"},
+				metricValues: [][]float64{{100}, {4}},
+				attributeMap: map[string]interface{}{
+					"PodName": "test-pod",
+				},
+			}),
+			inLogGroupName:   "test-log-group-{env:EMF_TEST_CLUSTER_NAME}",
+			inLogStreamName:  "test-log-stream-{PodName}-{env:EMF_TEST_CLUSTER_NAME}",
+			outLogGroupName:  "test-log-group-test-env-cluster",
+			outLogStreamName: "test-log-stream-test-pod-test-env-cluster",
+		},
+		{
+			name: "config_pattern_missing_from_env",
+			inputMetrics: generateTestMetrics(testMetric{
+				metricNames:  []string{"metric_1", "metric_2"},
+				metricValues: [][]float64{{100}, {4}},
+				attributeMap: map[string]interface{}{
+					"ClusterName": "test-cluster",
+				},
+			}),
+			inLogGroupName:   "test-log-group-{ClusterName}-{env:EMF_TEST_MISSING}",
+			inLogStreamName:  "test-log-stream",
+			outLogGroupName:  "test-log-group-test-cluster-undefined",
+			outLogStreamName: "test-log-stream",
+		},
 	}
 )
 
 func TestTranslateOtToGroupedMetricForLogGroupAndStream(t *testing.T) {
+	t.Setenv("EMF_TEST_CLUSTER_NAME", "test-env-cluster")
 	for _, test := range logGroupStreamTestCases {
 		t.Run(test.name, func(t *testing.T) {
 			config := &Config{
@@ -2508,6 +3444,81 @@ func TestTranslateOtToGroupedMetricForLogGroupAndStream(t *testing.T) {
 	}
 }
 
+func TestTranslateOtToGroupedMetricWithUnresolvedPatternPlaceholder(t *testing.T) {
+	testCases := []struct {
+		name             string
+		placeholder      string
+		resourceAttrs    map[string]interface{}
+		labels           map[string]interface{}
+		inLogGroupName   string
+		inLogStreamName  string
+		outLogGroupName  string
+		outLogStreamName string
+	}{
+		{
+			name:             "custom placeholder",
+			placeholder:      "unknown",
+			inLogGroupName:   "test-log-group-{ClusterName}",
+			inLogStreamName:  "test-log-stream-{PodName}",
+			outLogGroupName:  "test-log-group-unknown",
+			outLogStreamName: "test-log-stream-unknown",
+		},
+		{
+			name:        "custom placeholder with labels",
+			placeholder: "unknown",
+			labels: map[string]interface{}{
+				"PodName": "test-pod",
+			},
+			inLogGroupName:   "test-log-group-{ClusterName}",
+			inLogStreamName:  "test-log-stream-{PodName}",
+			outLogGroupName:  "test-log-group-unknown",
+			outLogStreamName: "test-log-stream-test-pod",
+		},
+		{
+			name: "log group literally named undefined",
+			resourceAttrs: map[string]interface{}{
+				"ClusterName": "test-cluster",
+			},
+			labels: map[string]interface{}{
+				"PodName": "test-pod",
+			},
+			inLogGroupName:   "undefined-prod-{ClusterName}",
+			inLogStreamName:  "test-log-stream-{PodName}",
+			outLogGroupName:  "undefined-prod-test-cluster",
+			outLogStreamName: "test-log-stream-test-pod",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := &Config{
+				LogGroupName:                 tc.inLogGroupName,
+				LogStreamName:                tc.inLogStreamName,
+				UnresolvedPatternPlaceholder: tc.placeholder,
+				DimensionRollupOption:        zeroAndSingleDimensionRollup,
+				logger:                       zap.NewNop(),
+			}
+			translator := newMetricTranslator(*config)
+			md := generateTestMetrics(testMetric{
+				metricNames:          []string{"metric_1"},
+				metricValues:         [][]float64{{100}},
+				resourceAttributeMap: tc.resourceAttrs,
+				attributeMap:         tc.labels,
+			})
+
+			groupedMetrics := make(map[interface{}]*groupedMetric)
+			err := translator.translateOTelToGroupedMetric(md.ResourceMetrics().At(0), groupedMetrics, config)
+			assert.Nil(t, err)
+
+			assert.Equal(t, 1, len(groupedMetrics))
+			for _, actual := range groupedMetrics {
+				assert.Equal(t, tc.outLogGroupName, actual.metadata.logGroup)
+				assert.Equal(t, tc.outLogStreamName, actual.metadata.logStream)
+			}
+		})
+	}
+}
+
 func generateTestMetrics(tm testMetric) pmetric.Metrics {
 	md := pmetric.NewMetrics()
 	now := time.Now()
@@ -0,0 +1,49 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsemfexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsemfexporter"
+
+import (
+	"context"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+)
+
+var (
+	mGroupedMetricsEmitted   = stats.Int64("awsemf_grouped_metrics_emitted", "Number of groups of metrics successfully sent as EMF logs", stats.UnitDimensionless)
+	mDuplicateMetricsDropped = stats.Int64("awsemf_duplicate_metrics_dropped", "Number of metrics dropped because a metric with the same name already exists in their group", stats.UnitDimensionless)
+	mGroupedMetricsFailed    = stats.Int64("awsemf_grouped_metrics_failed", "Number of groups of metrics that could not be serialized into EMF logs or sent", stats.UnitDimensionless)
+)
+
+// MetricViews returns the views of the internal metrics reported by the exporter.
+func MetricViews() []*view.View {
+	var views []*view.View
+	for _, m := range []*stats.Int64Measure{mGroupedMetricsEmitted, mDuplicateMetricsDropped, mGroupedMetricsFailed} {
+		views = append(views, &view.View{
+			Name:        m.Name(),
+			Measure:     m,
+			Description: m.Description(),
+			Aggregation: view.Sum(),
+		})
+	}
+	return views
+}
+
+// recordCount increments the measure by the given count.
+func recordCount(ctx context.Context, m *stats.Int64Measure, count int) {
+	if count > 0 {
+		stats.Record(ctx, m.M(int64(count)))
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsemfexporter
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/cwlogs"
+)
+
+func TestMetricViews(t *testing.T) {
+	expectedViewNames := []string{
+		"awsemf_grouped_metrics_emitted",
+		"awsemf_duplicate_metrics_dropped",
+		"awsemf_grouped_metrics_failed",
+	}
+
+	views := MetricViews()
+	require.Equal(t, len(expectedViewNames), len(views))
+	for i, viewName := range expectedViewNames {
+		assert.Equal(t, viewName, views[i].Name)
+	}
+}
+
+// viewSum returns the value of the sum view with the given name.
+func viewSum(t *testing.T, name string) int64 {
+	rows, err := view.RetrieveData(name)
+	require.NoError(t, err)
+	if len(rows) == 0 {
+		return 0
+	}
+	return int64(rows[0].Data.(*view.SumData).Value)
+}
+
+func newTestGauge(md pmetric.Metrics, name string, values ...float64) {
+	metric := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	metric.SetName(name)
+	dps := metric.SetEmptyGauge().DataPoints()
+	for _, value := range values {
+		dp := dps.AppendEmpty()
+		dp.SetTimestamp(pcommon.Timestamp(100_000_000_000))
+		dp.SetDoubleValue(value)
+	}
+}
+
+func TestDuplicateMetricsDroppedCounter(t *testing.T) {
+	views := MetricViews()
+	require.NoError(t, view.Register(views...))
+	defer view.Unregister(views...)
+
+	testCases := []struct {
+		name            string
+		strategy        string
+		expectedDropped int64
+	}{
+		{"drop", duplicateMetricStrategyDrop, 2},
+		{"overwrite", duplicateMetricStrategyOverwrite, 0},
+		{"aggregate", duplicateMetricStrategyAggregate, 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			md := pmetric.NewMetrics()
+			newTestGauge(md, "queueSize", 1, 2, 3)
+			metric := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+
+			groupedMetrics := make(map[interface{}]*groupedMetric)
+			metadata := generateTestMetricMetadata("namespace", 0, "test-duplicates", "stream", "cloudwatch-otel", metric.Type())
+			config := &Config{DuplicateMetricStrategy: tc.strategy, logger: zap.NewNop()}
+			dropped := viewSum(t, "awsemf_duplicate_metrics_dropped")
+			require.NoError(t, addToGroupedMetric(metric, groupedMetrics, metadata, true, zap.NewNop(), nil, config))
+
+			assert.Equal(t, 1, len(groupedMetrics))
+			assert.Equal(t, tc.expectedDropped, viewSum(t, "awsemf_duplicate_metrics_dropped")-dropped)
+		})
+	}
+}
+
+func TestGroupedMetricsEmittedAndFailedCounters(t *testing.T) {
+	views := MetricViews()
+	require.NoError(t, view.Register(views...))
+	defer view.Unregister(views...)
+
+	ctx := context.Background()
+	factory := NewFactory()
+	expCfg := factory.CreateDefaultConfig().(*Config)
+	expCfg.Region = "us-west-2"
+	expCfg.MaxRetries = 0
+	expCfg.OutputDestination = outputDestinationStdout
+	expCfg.LogGroupName = "test-emitted"
+	exp, err := newEmfPusher(expCfg, exportertest.NewNopCreateSettings())
+	require.NoError(t, err)
+
+	emitted := viewSum(t, "awsemf_grouped_metrics_emitted")
+	failed := viewSum(t, "awsemf_grouped_metrics_failed")
+	md := pmetric.NewMetrics()
+	newTestGauge(md, "queueSize", 7)
+	require.NoError(t, exp.(*emfExporter).pushMetricsData(ctx, md))
+	require.NoError(t, exp.(*emfExporter).pushMetricsData(ctx, md))
+	assert.Equal(t, int64(2), viewSum(t, "awsemf_grouped_metrics_emitted")-emitted)
+	assert.Equal(t, int64(0), viewSum(t, "awsemf_grouped_metrics_failed")-failed)
+
+	// NaN values are dropped by the default invalid value policy before they are grouped
+	md = pmetric.NewMetrics()
+	newTestGauge(md, "queueSize", math.NaN())
+	require.NoError(t, exp.(*emfExporter).pushMetricsData(ctx, md))
+	assert.Equal(t, int64(2), viewSum(t, "awsemf_grouped_metrics_emitted")-emitted)
+	assert.Equal(t, int64(0), viewSum(t, "awsemf_grouped_metrics_failed")-failed)
+	require.NoError(t, exp.Shutdown(ctx))
+}
+
+func TestGroupedMetricsEmittedAndFailedCountersWithCloudWatch(t *testing.T) {
+	views := MetricViews()
+	require.NoError(t, view.Register(views...))
+	defer view.Unregister(views...)
+
+	// 3 groups of metrics sent in batches of 2
+	md := pmetric.NewMetrics()
+	metric := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	metric.SetName("queueSize")
+	dps := metric.SetEmptyGauge().DataPoints()
+	for i := 0; i < 3; i++ {
+		dp := dps.AppendEmpty()
+		dp.SetIntValue(int64(i))
+		dp.SetTimestamp(pcommon.Timestamp(100_000_000_000))
+		dp.Attributes().PutStr("queue", fmt.Sprintf("queue-%d", i))
+	}
+
+	testCases := []struct {
+		name            string
+		dryRun          bool
+		addErrors       []string
+		flushErrors     []string
+		expectedEmitted int64
+		expectedFailed  int64
+	}{
+		{
+			name:            "sent",
+			addErrors:       []string{"", "", ""},
+			flushErrors:     []string{"", ""},
+			expectedEmitted: 3,
+		},
+		{
+			name:            "last batch not sent",
+			addErrors:       []string{"", "", ""},
+			flushErrors:     []string{"", "some error"},
+			expectedEmitted: 2,
+			expectedFailed:  1,
+		},
+		{
+			name:           "first batch not sent",
+			addErrors:      []string{"", ""},
+			flushErrors:    []string{"some error"},
+			expectedFailed: 2,
+		},
+		{
+			name:           "log entry not added",
+			addErrors:      []string{"", "some error"},
+			expectedFailed: 2,
+		},
+		{
+			name:   "dry run",
+			dryRun: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			expCfg := NewFactory().CreateDefaultConfig().(*Config)
+			expCfg.Region = "us-west-2"
+			expCfg.MaxRetries = 0
+			expCfg.LogGroupName = "test-logGroupName"
+			expCfg.LogStreamName = "test-logStreamName"
+			expCfg.MaxGroupsPerBatch = 2
+			expCfg.DryRun = tc.dryRun
+			exp, err := newEmfPusher(expCfg, exportertest.NewNopCreateSettings())
+			require.NoError(t, err)
+
+			logPusher := new(mockPusher)
+			for _, addError := range tc.addErrors {
+				logPusher.On("AddLogEntry", nil).Return(addError).Once()
+			}
+			for _, flushError := range tc.flushErrors {
+				logPusher.On("ForceFlush", nil).Return(flushError).Once()
+			}
+			exp.(*emfExporter).groupStreamToPusherMap = map[string]map[string]cwlogs.Pusher{
+				"test-logGroupName": {"test-logStreamName": logPusher},
+			}
+
+			emitted := viewSum(t, "awsemf_grouped_metrics_emitted")
+			failed := viewSum(t, "awsemf_grouped_metrics_failed")
+			err = exp.(*emfExporter).pushMetricsData(context.Background(), md)
+			assert.Equal(t, tc.expectedFailed > 0, err != nil)
+			logPusher.AssertExpectations(t)
+			assert.Equal(t, tc.expectedEmitted, viewSum(t, "awsemf_grouped_metrics_emitted")-emitted)
+			assert.Equal(t, tc.expectedFailed, viewSum(t, "awsemf_grouped_metrics_failed")-failed)
+		})
+	}
+}
@@ -16,6 +16,8 @@ package awsemfexporter // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"fmt"
+	"os"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
@@ -35,35 +37,72 @@ var patternKeyToAttributeMap = map[string]string{
 	"TaskDefinitionFamily": "aws.ecs.task.family",
 }
 
-func replacePatterns(s string, attrMap map[string]string, logger *zap.Logger) (string, bool) {
+// envPatternRegexp matches the "{env:NAME}" patterns which are replaced with the value of the environment variable NAME.
+var envPatternRegexp = regexp.MustCompile(`\{env:([a-zA-Z_][a-zA-Z0-9_]*)\}`)
+
+// datePatternRegexp matches the "{date:LAYOUT}" patterns which are replaced with the timestamp of the metrics
+// formatted with the Go time layout LAYOUT.
+var datePatternRegexp = regexp.MustCompile(`\{date:([^{}]+)\}`)
+
+// replacePatterns replaces the patterns of s with the values of the attributes or environment variables they refer to.
+// Patterns that cannot be resolved are replaced with the placeholder, in which case false is returned.
+func replacePatterns(s string, attrMap map[string]string, placeholder string, logger *zap.Logger) (string, bool) {
 	success := true
 	var foundAndReplaced bool
 	for key := range patternKeyToAttributeMap {
-		s, foundAndReplaced = replacePatternWithAttrValue(s, key, attrMap, logger)
+		s, foundAndReplaced = replacePatternWithAttrValue(s, key, attrMap, placeholder, logger)
 		success = success && foundAndReplaced
 	}
+	s, foundAndReplaced = replaceEnvPatterns(s, placeholder, logger)
+	return s, success && foundAndReplaced
+}
+
+// replaceEnvPatterns replaces the "{env:NAME}" patterns with the value of the environment variable NAME,
+// or with the placeholder if it is not set or empty.
+func replaceEnvPatterns(s string, placeholder string, logger *zap.Logger) (string, bool) {
+	success := true
+	s = envPatternRegexp.ReplaceAllStringFunc(s, func(pattern string) string {
+		name := envPatternRegexp.FindStringSubmatch(pattern)[1]
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+		logger.Debug("No environment variable found for pattern " + pattern)
+		success = false
+		return placeholder
+	})
 	return s, success
 }
 
-func replacePatternWithAttrValue(s, patternKey string, attrMap map[string]string, logger *zap.Logger) (string, bool) {
+// replaceDatePatterns replaces the "{date:LAYOUT}" patterns with the UTC time of timestampMs formatted with LAYOUT.
+func replaceDatePatterns(s string, timestampMs int64) string {
+	if !strings.Contains(s, "{date:") {
+		return s
+	}
+	t := time.UnixMilli(timestampMs).UTC()
+	return datePatternRegexp.ReplaceAllStringFunc(s, func(pattern string) string {
+		return t.Format(datePatternRegexp.FindStringSubmatch(pattern)[1])
+	})
+}
+
+func replacePatternWithAttrValue(s, patternKey string, attrMap map[string]string, placeholder string, logger *zap.Logger) (string, bool) {
 	pattern := "{" + patternKey + "}"
 	if strings.Contains(s, pattern) {
 		if value, ok := attrMap[patternKey]; ok {
-			return replace(s, pattern, value, logger)
+			return replace(s, pattern, value, placeholder, logger)
 		} else if value, ok := attrMap[patternKeyToAttributeMap[patternKey]]; ok {
-			return replace(s, pattern, value, logger)
+			return replace(s, pattern, value, placeholder, logger)
 		} else {
 			logger.Debug("No resource attribute found for pattern " + pattern)
-			return strings.ReplaceAll(s, pattern, "undefined"), false
+			return strings.ReplaceAll(s, pattern, placeholder), false
 		}
 	}
 	return s, true
 }
 
-func replace(s, pattern string, value string, logger *zap.Logger) (string, bool) {
+func replace(s, pattern string, value string, placeholder string, logger *zap.Logger) (string, bool) {
 	if value == "" {
 		logger.Debug("Empty resource attribute value found for pattern " + pattern)
-		return strings.ReplaceAll(s, pattern, "undefined"), false
+		return strings.ReplaceAll(s, pattern, placeholder), false
 	}
 	return strings.ReplaceAll(s, pattern, value), true
 }
@@ -89,8 +128,9 @@ func getNamespace(rm pmetric.ResourceMetrics, namespace string) string {
 	return namespace
 }
 
-// getLogInfo retrieves the log group and log stream names from a given set of metrics.
-func getLogInfo(rm pmetric.ResourceMetrics, cWNamespace string, config *Config) (string, string, bool) {
+// getLogInfo retrieves the log group and log stream names from a given set of metrics, and whether
+// their patterns were all replaced.
+func getLogInfo(rm pmetric.ResourceMetrics, cWNamespace string, config *Config) (string, string, bool, bool) {
 	var logGroup, logStream string
 	groupReplaced := true
 	streamReplaced := true
@@ -103,13 +143,13 @@ func getLogInfo(rm pmetric.ResourceMetrics, cWNamespace string, config *Config)
 
 	// Override log group/stream if specified in config. However, in this case, customer won't have correlation experience
 	if len(config.LogGroupName) > 0 {
-		logGroup, groupReplaced = replacePatterns(config.LogGroupName, strAttributeMap, config.logger)
+		logGroup, groupReplaced = replacePatterns(config.LogGroupName, strAttributeMap, config.unresolvedPatternPlaceholder(), config.logger)
 	}
 	if len(config.LogStreamName) > 0 {
-		logStream, streamReplaced = replacePatterns(config.LogStreamName, strAttributeMap, config.logger)
+		logStream, streamReplaced = replacePatterns(config.LogStreamName, strAttributeMap, config.unresolvedPatternPlaceholder(), config.logger)
 	}
 
-	return logGroup, logStream, (groupReplaced && streamReplaced)
+	return logGroup, logStream, groupReplaced, streamReplaced
 }
 
 // dedupDimensions removes duplicated dimension sets from the given dimensions.
@@ -167,6 +207,11 @@ func dimensionRollup(dimensionRollupOption string, labels map[string]string) [][
 	return rollupDimensionArray
 }
 
+// replaceNewlines replaces each newline ("\r\n", "\n" or "\r") in s with the given replacement.
+func replaceNewlines(s, replacement string) string {
+	return strings.NewReplacer("\r\n", replacement, "\n", replacement, "\r", replacement).Replace(s)
+}
+
 // unixNanoToMilliseconds converts a timestamp in nanoseconds to milliseconds.
 func unixNanoToMilliseconds(timestamp pcommon.Timestamp) int64 {
 	return int64(uint64(timestamp) / uint64(time.Millisecond))
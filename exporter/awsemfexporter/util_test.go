@@ -16,6 +16,7 @@ package awsemfexporter
 
 import (
 	"testing"
+	"time"
 
 	commonpb "github.com/census-instrumentation/opencensus-proto/gen-go/agent/common/v1"
 	agentmetricspb "github.com/census-instrumentation/opencensus-proto/gen-go/agent/metrics/v1"
@@ -38,7 +39,7 @@ func TestReplacePatternValidTaskId(t *testing.T) {
 	attrMap.PutStr("aws.ecs.cluster.name", "test-cluster-name")
 	attrMap.PutStr("aws.ecs.task.id", "test-task-id")
 
-	s, success := replacePatterns(input, attrMaptoStringMap(attrMap), logger)
+	s, success := replacePatterns(input, attrMaptoStringMap(attrMap), defaultUnresolvedPatternPlaceholder, logger)
 
 	assert.Equal(t, "test-task-id", s)
 	assert.True(t, success)
@@ -53,7 +54,7 @@ func TestReplacePatternValidClusterName(t *testing.T) {
 	attrMap.PutStr("aws.ecs.cluster.name", "test-cluster-name")
 	attrMap.PutStr("aws.ecs.task.id", "test-task-id")
 
-	s, success := replacePatterns(input, attrMaptoStringMap(attrMap), logger)
+	s, success := replacePatterns(input, attrMaptoStringMap(attrMap), defaultUnresolvedPatternPlaceholder, logger)
 
 	assert.Equal(t, "/aws/ecs/containerinsights/test-cluster-name/performance", s)
 	assert.True(t, success)
@@ -67,7 +68,7 @@ func TestReplacePatternMissingAttribute(t *testing.T) {
 	attrMap := pcommon.NewMap()
 	attrMap.PutStr("aws.ecs.task.id", "test-task-id")
 
-	s, success := replacePatterns(input, attrMaptoStringMap(attrMap), logger)
+	s, success := replacePatterns(input, attrMaptoStringMap(attrMap), defaultUnresolvedPatternPlaceholder, logger)
 
 	assert.Equal(t, "/aws/ecs/containerinsights/undefined/performance", s)
 	assert.False(t, success)
@@ -82,7 +83,7 @@ func TestReplacePatternValidPodName(t *testing.T) {
 	attrMap.PutStr("aws.eks.cluster.name", "test-cluster-name")
 	attrMap.PutStr("PodName", "test-pod-001")
 
-	s, success := replacePatterns(input, attrMaptoStringMap(attrMap), logger)
+	s, success := replacePatterns(input, attrMaptoStringMap(attrMap), defaultUnresolvedPatternPlaceholder, logger)
 
 	assert.Equal(t, "/aws/eks/containerinsights/test-pod-001/performance", s)
 	assert.True(t, success)
@@ -97,7 +98,7 @@ func TestReplacePatternValidPod(t *testing.T) {
 	attrMap.PutStr("aws.eks.cluster.name", "test-cluster-name")
 	attrMap.PutStr("pod", "test-pod-001")
 
-	s, success := replacePatterns(input, attrMaptoStringMap(attrMap), logger)
+	s, success := replacePatterns(input, attrMaptoStringMap(attrMap), defaultUnresolvedPatternPlaceholder, logger)
 
 	assert.Equal(t, "/aws/eks/containerinsights/test-pod-001/performance", s)
 	assert.True(t, success)
@@ -111,7 +112,7 @@ func TestReplacePatternMissingPodName(t *testing.T) {
 	attrMap := pcommon.NewMap()
 	attrMap.PutStr("aws.eks.cluster.name", "test-cluster-name")
 
-	s, success := replacePatterns(input, attrMaptoStringMap(attrMap), logger)
+	s, success := replacePatterns(input, attrMaptoStringMap(attrMap), defaultUnresolvedPatternPlaceholder, logger)
 
 	assert.Equal(t, "/aws/eks/containerinsights/undefined/performance", s)
 	assert.False(t, success)
@@ -125,7 +126,7 @@ func TestReplacePatternAttrPlaceholderClusterName(t *testing.T) {
 	attrMap := pcommon.NewMap()
 	attrMap.PutStr("ClusterName", "test-cluster-name")
 
-	s, success := replacePatterns(input, attrMaptoStringMap(attrMap), logger)
+	s, success := replacePatterns(input, attrMaptoStringMap(attrMap), defaultUnresolvedPatternPlaceholder, logger)
 
 	assert.Equal(t, "/aws/ecs/containerinsights/test-cluster-name/performance", s)
 	assert.True(t, success)
@@ -139,7 +140,7 @@ func TestReplacePatternWrongKey(t *testing.T) {
 	attrMap := pcommon.NewMap()
 	attrMap.PutStr("ClusterName", "test-task-id")
 
-	s, success := replacePatterns(input, attrMaptoStringMap(attrMap), logger)
+	s, success := replacePatterns(input, attrMaptoStringMap(attrMap), defaultUnresolvedPatternPlaceholder, logger)
 
 	assert.Equal(t, "/aws/ecs/containerinsights/{WrongKey}/performance", s)
 	assert.True(t, success)
@@ -153,7 +154,7 @@ func TestReplacePatternNilAttrValue(t *testing.T) {
 	attrMap := pcommon.NewMap()
 	attrMap.PutEmpty("ClusterName")
 
-	s, success := replacePatterns(input, attrMaptoStringMap(attrMap), logger)
+	s, success := replacePatterns(input, attrMaptoStringMap(attrMap), defaultUnresolvedPatternPlaceholder, logger)
 
 	assert.Equal(t, "/aws/ecs/containerinsights/undefined/performance", s)
 	assert.False(t, success)
@@ -168,12 +169,105 @@ func TestReplacePatternValidTaskDefinitionFamily(t *testing.T) {
 	attrMap.PutStr("aws.ecs.cluster.name", "test-cluster-name")
 	attrMap.PutStr("aws.ecs.task.family", "test-task-definition-family")
 
-	s, success := replacePatterns(input, attrMaptoStringMap(attrMap), logger)
+	s, success := replacePatterns(input, attrMaptoStringMap(attrMap), defaultUnresolvedPatternPlaceholder, logger)
 
 	assert.Equal(t, "test-task-definition-family", s)
 	assert.True(t, success)
 }
 
+func TestReplacePatternEnv(t *testing.T) {
+	logger := zap.NewNop()
+	t.Setenv("EMF_TEST_CLUSTER_NAME", "test-cluster-name")
+
+	testCases := []struct {
+		name            string
+		input           string
+		attrs           map[string]string
+		expected        string
+		expectedSuccess bool
+	}{
+		{
+			"env",
+			"/aws/containerinsights/{env:EMF_TEST_CLUSTER_NAME}/performance",
+			map[string]string{},
+			"/aws/containerinsights/test-cluster-name/performance",
+			true,
+		},
+		{
+			"env and attribute",
+			"/aws/containerinsights/{env:EMF_TEST_CLUSTER_NAME}/{TaskId}",
+			map[string]string{"aws.ecs.task.id": "test-task-id"},
+			"/aws/containerinsights/test-cluster-name/test-task-id",
+			true,
+		},
+		{
+			"missing env",
+			"/aws/containerinsights/{env:EMF_TEST_MISSING}/performance",
+			map[string]string{},
+			"/aws/containerinsights/undefined/performance",
+			false,
+		},
+		{
+			"invalid env name",
+			"/aws/containerinsights/{env:1NVALID}/performance",
+			map[string]string{},
+			"/aws/containerinsights/{env:1NVALID}/performance",
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, success := replacePatterns(tc.input, tc.attrs, defaultUnresolvedPatternPlaceholder, logger)
+			assert.Equal(t, tc.expected, s)
+			assert.Equal(t, tc.expectedSuccess, success)
+		})
+	}
+}
+
+func TestReplaceDatePatterns(t *testing.T) {
+	// 2024-06-01T13:45:30.5Z
+	timestampMs := time.Date(2024, time.June, 1, 13, 45, 30, 500*int(time.Millisecond), time.UTC).UnixMilli()
+
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			"date",
+			"metrics-{date:2006-01-02}",
+			"metrics-2024-06-01",
+		},
+		{
+			"hour",
+			"metrics-{date:2006-01-02-15}",
+			"metrics-2024-06-01-13",
+		},
+		{
+			"multiple patterns",
+			"/metrics/{date:2006}/{date:01}/{TaskId}",
+			"/metrics/2024/06/{TaskId}",
+		},
+		{
+			"no pattern",
+			"metrics",
+			"metrics",
+		},
+		{
+			"empty layout",
+			"metrics-{date:}",
+			"metrics-{date:}",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, replaceDatePatterns(tc.input, timestampMs))
+		})
+	}
+}
+
 func TestGetNamespace(t *testing.T) {
 	defaultMetric := createMetricTestData()
 	testCases := []struct {
@@ -360,10 +454,11 @@ func TestGetLogInfo(t *testing.T) {
 					LogGroupName:  tc.configLogGroup,
 					LogStreamName: tc.configLogStream,
 				}
-				logGroup, logStream, success := getLogInfo(rms[i], tc.namespace, config)
+				logGroup, logStream, groupReplaced, streamReplaced := getLogInfo(rms[i], tc.namespace, config)
 				assert.Equal(t, tc.logGroup, logGroup)
 				assert.Equal(t, tc.logStream, logStream)
-				assert.True(t, success)
+				assert.True(t, groupReplaced)
+				assert.True(t, streamReplaced)
 			})
 		}
 	}
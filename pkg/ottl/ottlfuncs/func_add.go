@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+var errIntegerOverflow = errors.New("integer overflow")
+
+// Add factory function returns the sum of the left and right numbers. The result is an int64 if both numbers are
+// int64, a float64 otherwise. An error is returned if the sum of two int64 overflows.
+func Add[K any](left ottl.Getter[K], right ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return arithmetic(left, right, func(a, b int64) (int64, error) {
+		c := a + b
+		if (b > 0 && c < a) || (b < 0 && c > a) {
+			return 0, errIntegerOverflow
+		}
+		return c, nil
+	}, func(a, b float64) (float64, error) {
+		return a + b, nil
+	}), nil
+}
+
+// arithmetic returns an ExprFunc applying intOp to the left and right numbers if both are int64, and floatOp to the
+// numbers converted to float64 otherwise.
+func arithmetic[K any](left ottl.Getter[K], right ottl.Getter[K], intOp func(a, b int64) (int64, error), floatOp func(a, b float64) (float64, error)) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		leftVal, err := left.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		rightVal, err := right.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		leftInt, leftIsInt := leftVal.(int64)
+		rightInt, rightIsInt := rightVal.(int64)
+		if leftIsInt && rightIsInt {
+			return intOp(leftInt, rightInt)
+		}
+		leftFloat, err := toFloat64(leftVal)
+		if err != nil {
+			return nil, err
+		}
+		rightFloat, err := toFloat64(rightVal)
+		if err != nil {
+			return nil, err
+		}
+		return floatOp(leftFloat, rightFloat)
+	}
+}
+
+// toFloat64 converts an int64 or float64 operand to a float64.
+func toFloat64(val interface{}) (float64, error) {
+	switch v := val.(type) {
+	case int64:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	default:
+		return 0, fmt.Errorf("operands must be int64 or float64 but got %T", val)
+	}
+}
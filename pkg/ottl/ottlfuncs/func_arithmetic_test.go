@@ -0,0 +1,424 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Arithmetic(t *testing.T) {
+	tests := []struct {
+		name     string
+		function func(ottl.Getter[interface{}], ottl.Getter[interface{}]) (ottl.ExprFunc[interface{}], error)
+		left     interface{}
+		right    interface{}
+		expected interface{}
+	}{
+		{
+			name:     "add ints",
+			function: Add[interface{}],
+			left:     int64(3),
+			right:    int64(4),
+			expected: int64(7),
+		},
+		{
+			name:     "add doubles",
+			function: Add[interface{}],
+			left:     1.5,
+			right:    2.25,
+			expected: 3.75,
+		},
+		{
+			name:     "add int and double",
+			function: Add[interface{}],
+			left:     int64(1),
+			right:    0.5,
+			expected: 1.5,
+		},
+		{
+			name:     "subtract ints",
+			function: Subtract[interface{}],
+			left:     int64(3),
+			right:    int64(5),
+			expected: int64(-2),
+		},
+		{
+			name:     "subtract double and int",
+			function: Subtract[interface{}],
+			left:     2.5,
+			right:    int64(1),
+			expected: 1.5,
+		},
+		{
+			name:     "multiply ints",
+			function: Multiply[interface{}],
+			left:     int64(-6),
+			right:    int64(7),
+			expected: int64(-42),
+		},
+		{
+			name:     "multiply int by zero",
+			function: Multiply[interface{}],
+			left:     int64(math.MinInt64),
+			right:    int64(0),
+			expected: int64(0),
+		},
+		{
+			name:     "multiply int and double",
+			function: Multiply[interface{}],
+			left:     int64(3),
+			right:    0.5,
+			expected: 1.5,
+		},
+		{
+			name:     "divide ints",
+			function: Divide[interface{}],
+			left:     int64(7),
+			right:    int64(2),
+			expected: int64(3),
+		},
+		{
+			name:     "divide negative ints truncates towards zero",
+			function: Divide[interface{}],
+			left:     int64(-7),
+			right:    int64(2),
+			expected: int64(-3),
+		},
+		{
+			name:     "divide int and double",
+			function: Divide[interface{}],
+			left:     int64(7),
+			right:    2.0,
+			expected: 3.5,
+		},
+		{
+			name:     "divide doubles",
+			function: Divide[interface{}],
+			left:     1024.0,
+			right:    4.0,
+			expected: 256.0,
+		},
+		{
+			name:     "ratio of ints",
+			function: Ratio[interface{}],
+			left:     int64(1),
+			right:    int64(4),
+			expected: 0.25,
+		},
+		{
+			name:     "ratio of negative int and double",
+			function: Ratio[interface{}],
+			left:     int64(-3),
+			right:    1.5,
+			expected: -2.0,
+		},
+		{
+			name:     "ratio of doubles",
+			function: Ratio[interface{}],
+			left:     -1.0,
+			right:    -8.0,
+			expected: 0.125,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := tt.function(constGetter(tt.left), constGetter(tt.right))
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Arithmetic_Error(t *testing.T) {
+	tests := []struct {
+		name        string
+		function    func(ottl.Getter[interface{}], ottl.Getter[interface{}]) (ottl.ExprFunc[interface{}], error)
+		left        interface{}
+		right       interface{}
+		expectedErr string
+	}{
+		{
+			name:        "divide ints by zero",
+			function:    Divide[interface{}],
+			left:        int64(1),
+			right:       int64(0),
+			expectedErr: "division by zero",
+		},
+		{
+			name:        "divide doubles by zero",
+			function:    Divide[interface{}],
+			left:        1.0,
+			right:       0.0,
+			expectedErr: "division by zero",
+		},
+		{
+			name:        "divide double by int zero",
+			function:    Divide[interface{}],
+			left:        1.5,
+			right:       int64(0),
+			expectedErr: "division by zero",
+		},
+		{
+			name:        "divide overflow",
+			function:    Divide[interface{}],
+			left:        int64(math.MinInt64),
+			right:       int64(-1),
+			expectedErr: "integer overflow",
+		},
+		{
+			name:        "ratio of int zero",
+			function:    Ratio[interface{}],
+			left:        int64(1),
+			right:       int64(0),
+			expectedErr: "division by zero",
+		},
+		{
+			name:        "ratio of double zero",
+			function:    Ratio[interface{}],
+			left:        0.0,
+			right:       0.0,
+			expectedErr: "division by zero",
+		},
+		{
+			name:        "ratio of string",
+			function:    Ratio[interface{}],
+			left:        "1",
+			right:       int64(2),
+			expectedErr: "operands must be int64 or float64 but got string",
+		},
+		{
+			name:        "add overflow",
+			function:    Add[interface{}],
+			left:        int64(math.MaxInt64),
+			right:       int64(1),
+			expectedErr: "integer overflow",
+		},
+		{
+			name:        "subtract overflow",
+			function:    Subtract[interface{}],
+			left:        int64(math.MinInt64),
+			right:       int64(1),
+			expectedErr: "integer overflow",
+		},
+		{
+			name:        "multiply overflow",
+			function:    Multiply[interface{}],
+			left:        int64(math.MaxInt64),
+			right:       int64(2),
+			expectedErr: "integer overflow",
+		},
+		{
+			name:        "multiply min int overflow",
+			function:    Multiply[interface{}],
+			left:        int64(math.MinInt64),
+			right:       int64(-1),
+			expectedErr: "integer overflow",
+		},
+		{
+			name:        "string operand",
+			function:    Add[interface{}],
+			left:        int64(1),
+			right:       "1",
+			expectedErr: "operands must be int64 or float64 but got string",
+		},
+		{
+			name:        "nil operand",
+			function:    Multiply[interface{}],
+			left:        nil,
+			right:       1.0,
+			expectedErr: "operands must be int64 or float64 but got <nil>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := tt.function(constGetter(tt.left), constGetter(tt.right))
+			require.NoError(t, err)
+			_, err = exprFunc(nil, nil)
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func Test_MinMax(t *testing.T) {
+	tests := []struct {
+		name        string
+		left        interface{}
+		right       interface{}
+		expectedMin interface{}
+		expectedMax interface{}
+	}{
+		{
+			name:        "ints",
+			left:        int64(3),
+			right:       int64(7),
+			expectedMin: int64(3),
+			expectedMax: int64(7),
+		},
+		{
+			name:        "negative ints",
+			left:        int64(-3),
+			right:       int64(-7),
+			expectedMin: int64(-7),
+			expectedMax: int64(-3),
+		},
+		{
+			name:        "equal ints",
+			left:        int64(5),
+			right:       int64(5),
+			expectedMin: int64(5),
+			expectedMax: int64(5),
+		},
+		{
+			name:        "doubles",
+			left:        -1.5,
+			right:       2.5,
+			expectedMin: -1.5,
+			expectedMax: 2.5,
+		},
+		{
+			name:        "int and double promote to double",
+			left:        int64(2),
+			right:       2.5,
+			expectedMin: 2.0,
+			expectedMax: 2.5,
+		},
+		{
+			name:        "double and negative int promote to double",
+			left:        0.5,
+			right:       int64(-4),
+			expectedMin: -4.0,
+			expectedMax: 0.5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minFunc, err := Min[interface{}](constGetter(tt.left), constGetter(tt.right))
+			require.NoError(t, err)
+			result, err := minFunc(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedMin, result)
+
+			maxFunc, err := Max[interface{}](constGetter(tt.left), constGetter(tt.right))
+			require.NoError(t, err)
+			result, err = maxFunc(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedMax, result)
+		})
+	}
+}
+
+func Test_MinMax_Error(t *testing.T) {
+	minFunc, err := Min[interface{}](constGetter(int64(1)), constGetter("2"))
+	require.NoError(t, err)
+	_, err = minFunc(nil, nil)
+	assert.EqualError(t, err, "operands must be int64 or float64 but got string")
+
+	maxFunc, err := Max[interface{}](constGetter(nil), constGetter(int64(2)))
+	require.NoError(t, err)
+	_, err = maxFunc(nil, nil)
+	assert.EqualError(t, err, "operands must be int64 or float64 but got <nil>")
+}
+
+func Test_Abs(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{
+			name:     "positive int",
+			value:    int64(5),
+			expected: int64(5),
+		},
+		{
+			name:     "negative int",
+			value:    int64(-5),
+			expected: int64(5),
+		},
+		{
+			name:     "max int",
+			value:    int64(-math.MaxInt64),
+			expected: int64(math.MaxInt64),
+		},
+		{
+			name:     "negative double",
+			value:    -2.5,
+			expected: 2.5,
+		},
+		{
+			name:     "positive double",
+			value:    0.1,
+			expected: 0.1,
+		},
+		{
+			name:     "negative infinity",
+			value:    math.Inf(-1),
+			expected: math.Inf(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Abs[interface{}](constGetter(tt.value))
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Abs_Error(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       interface{}
+		expectedErr string
+	}{
+		{
+			name:        "min int",
+			value:       int64(math.MinInt64),
+			expectedErr: "integer overflow",
+		},
+		{
+			name:        "string",
+			value:       "-1",
+			expectedErr: "target must be an int64 or float64 but got string",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Abs[interface{}](constGetter(tt.value))
+			require.NoError(t, err)
+			_, err = exprFunc(nil, nil)
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func constGetter(value interface{}) ottl.Getter[interface{}] {
+	return &ottl.StandardGetSetter[interface{}]{
+		Getter: func(context.Context, interface{}) (interface{}, error) {
+			return value, nil
+		},
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// Average factory function returns the arithmetic mean of the numbers of the target `pcommon.Slice` as a float64.
+// An error is returned if the slice is empty or has an element that is not an int64 or a float64.
+func Average[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return sliceAggregate(target, func(numbers []float64) float64 {
+		var sum float64
+		for _, n := range numbers {
+			sum += n
+		}
+		return sum / float64(len(numbers))
+	}), nil
+}
@@ -0,0 +1,155 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// BuildURL factory function returns a URL string built from the components of the target map.
+// The following keys of the map are used:
+//
+//	scheme   -> the URL scheme, required
+//	user     -> the user name
+//	host     -> the host name or IP address without a port, required
+//	port     -> the port between 1 and 65535, either an int or a string
+//	path     -> the URL path
+//	query    -> a map of the query parameters, a slice value adds the parameter once per element
+//	fragment -> the URL fragment
+func BuildURL[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		targetVal, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		components, ok := targetVal.(pcommon.Map)
+		if !ok {
+			return nil, fmt.Errorf("target must be a map but got %T", targetVal)
+		}
+
+		scheme, err := requiredURLComponent(components, "scheme")
+		if err != nil {
+			return nil, err
+		}
+		host, err := requiredURLComponent(components, "host")
+		if err != nil {
+			return nil, err
+		}
+		host, err = buildURLHost(host)
+		if err != nil {
+			return nil, err
+		}
+
+		if port, ok := components.Get("port"); ok {
+			portStr, err := buildURLPort(port)
+			if err != nil {
+				return nil, err
+			}
+			if portStr != "" {
+				host += ":" + portStr
+			}
+		}
+
+		u := url.URL{
+			Scheme: scheme,
+			Host:   host,
+		}
+		if user, ok := components.Get("user"); ok && user.AsString() != "" {
+			u.User = url.User(user.AsString())
+		}
+		if path, ok := components.Get("path"); ok {
+			u.Path = path.AsString()
+		}
+		if query, ok := components.Get("query"); ok {
+			if query.Type() != pcommon.ValueTypeMap {
+				return nil, fmt.Errorf("URL component \"query\" must be a map but got %v", query.Type())
+			}
+			values := url.Values{}
+			query.Map().Range(func(k string, v pcommon.Value) bool {
+				if v.Type() == pcommon.ValueTypeSlice {
+					for i := 0; i < v.Slice().Len(); i++ {
+						values.Add(k, v.Slice().At(i).AsString())
+					}
+					return true
+				}
+				values.Add(k, v.AsString())
+				return true
+			})
+			u.RawQuery = values.Encode()
+		}
+		if fragment, ok := components.Get("fragment"); ok {
+			u.Fragment = fragment.AsString()
+		}
+		return u.String(), nil
+	}, nil
+}
+
+func requiredURLComponent(components pcommon.Map, key string) (string, error) {
+	value, ok := components.Get(key)
+	if !ok || value.Type() != pcommon.ValueTypeStr || value.Str() == "" {
+		return "", fmt.Errorf("URL component %q must be a non-empty string", key)
+	}
+	return value.Str(), nil
+}
+
+// buildURLHost returns the host as used in a URL, IPv6 addresses are enclosed in square brackets.
+// An error is returned if the host contains a port.
+func buildURLHost(host string) (string, error) {
+	ip := strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+	if strings.Contains(ip, ":") {
+		if net.ParseIP(ip) == nil {
+			return "", fmt.Errorf("URL component \"host\" must not contain a port but got %q", host)
+		}
+		return "[" + ip + "]", nil
+	}
+	if ip != host {
+		return "", fmt.Errorf("URL component \"host\" is not a valid IPv6 address: %q", host)
+	}
+	return host, nil
+}
+
+// buildURLPort returns the port as used in a URL, or an empty string for an empty string port.
+// An error is returned if the port is not between 1 and 65535.
+func buildURLPort(port pcommon.Value) (string, error) {
+	var portNum int64
+	switch port.Type() {
+	case pcommon.ValueTypeInt:
+		portNum = port.Int()
+	case pcommon.ValueTypeStr:
+		if port.Str() == "" {
+			return "", nil
+		}
+		var err error
+		portNum, err = strconv.ParseInt(port.Str(), 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("URL component \"port\" is not a valid port: %w", err)
+		}
+	default:
+		return "", fmt.Errorf("URL component \"port\" must be an int or a string but got %v", port.Type())
+	}
+	if portNum < 1 || portNum > 65535 {
+		return "", fmt.Errorf("URL component \"port\" must be between 1 and 65535 but got %d", portNum)
+	}
+	return strconv.FormatInt(portNum, 10), nil
+}
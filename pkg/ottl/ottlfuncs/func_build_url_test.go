@@ -0,0 +1,255 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_BuildURL(t *testing.T) {
+	tests := []struct {
+		name       string
+		components map[string]interface{}
+		expected   string
+	}{
+		{
+			name: "scheme and host",
+			components: map[string]interface{}{
+				"scheme": "https",
+				"host":   "example.com",
+			},
+			expected: "https://example.com",
+		},
+		{
+			name: "all components",
+			components: map[string]interface{}{
+				"scheme": "http",
+				"host":   "example.com",
+				"port":   8080,
+				"path":   "/api/v1/users",
+				"query": map[string]interface{}{
+					"name":   "john doe",
+					"filter": "a&b=c",
+					"id":     []interface{}{1, 2},
+				},
+			},
+			expected: "http://example.com:8080/api/v1/users?filter=a%26b%3Dc&id=1&id=2&name=john+doe",
+		},
+		{
+			name: "string port",
+			components: map[string]interface{}{
+				"scheme": "https",
+				"host":   "example.com",
+				"port":   "8443",
+			},
+			expected: "https://example.com:8443",
+		},
+		{
+			name: "ipv6 host with port",
+			components: map[string]interface{}{
+				"scheme": "http",
+				"host":   "::1",
+				"port":   80,
+			},
+			expected: "http://[::1]:80",
+		},
+		{
+			name: "ipv6 host without port",
+			components: map[string]interface{}{
+				"scheme": "http",
+				"host":   "2001:db8::1",
+			},
+			expected: "http://[2001:db8::1]",
+		},
+		{
+			name: "bracketed ipv6 host",
+			components: map[string]interface{}{
+				"scheme": "http",
+				"host":   "[::1]",
+				"port":   "8080",
+			},
+			expected: "http://[::1]:8080",
+		},
+		{
+			name: "empty string port",
+			components: map[string]interface{}{
+				"scheme": "https",
+				"host":   "example.com",
+				"port":   "",
+			},
+			expected: "https://example.com",
+		},
+		{
+			name: "path without leading slash",
+			components: map[string]interface{}{
+				"scheme": "https",
+				"host":   "example.com",
+				"path":   "a b/c",
+			},
+			expected: "https://example.com/a%20b/c",
+		},
+		{
+			name: "user and fragment",
+			components: map[string]interface{}{
+				"scheme":   "https",
+				"user":     "john doe",
+				"host":     "example.com",
+				"path":     "/docs",
+				"fragment": "section 2",
+			},
+			expected: "https://john%20doe@example.com/docs#section%202",
+		},
+		{
+			name: "empty query",
+			components: map[string]interface{}{
+				"scheme": "https",
+				"host":   "example.com",
+				"query":  map[string]interface{}{},
+			},
+			expected: "https://example.com",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			components := pcommon.NewMap()
+			assert.NoError(t, components.FromRaw(tt.components))
+			exprFunc, err := BuildURL[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return components, nil
+				},
+			})
+			assert.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_BuildURL_error(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{
+			name: "missing scheme",
+			value: func() pcommon.Map {
+				m := pcommon.NewMap()
+				m.PutStr("host", "example.com")
+				return m
+			}(),
+		},
+		{
+			name: "missing host",
+			value: func() pcommon.Map {
+				m := pcommon.NewMap()
+				m.PutStr("scheme", "https")
+				return m
+			}(),
+		},
+		{
+			name: "invalid port",
+			value: func() pcommon.Map {
+				m := pcommon.NewMap()
+				m.PutStr("scheme", "https")
+				m.PutStr("host", "example.com")
+				m.PutBool("port", true)
+				return m
+			}(),
+		},
+		{
+			name: "host with port",
+			value: func() pcommon.Map {
+				m := pcommon.NewMap()
+				m.PutStr("scheme", "https")
+				m.PutStr("host", "example.com:80")
+				m.PutInt("port", 8080)
+				return m
+			}(),
+		},
+		{
+			name: "invalid bracketed host",
+			value: func() pcommon.Map {
+				m := pcommon.NewMap()
+				m.PutStr("scheme", "https")
+				m.PutStr("host", "[example.com]")
+				return m
+			}(),
+		},
+		{
+			name: "negative port",
+			value: func() pcommon.Map {
+				m := pcommon.NewMap()
+				m.PutStr("scheme", "https")
+				m.PutStr("host", "example.com")
+				m.PutInt("port", -1)
+				return m
+			}(),
+		},
+		{
+			name: "out of range port",
+			value: func() pcommon.Map {
+				m := pcommon.NewMap()
+				m.PutStr("scheme", "https")
+				m.PutStr("host", "example.com")
+				m.PutStr("port", "65536")
+				return m
+			}(),
+		},
+		{
+			name: "non numeric port",
+			value: func() pcommon.Map {
+				m := pcommon.NewMap()
+				m.PutStr("scheme", "https")
+				m.PutStr("host", "example.com")
+				m.PutStr("port", "http")
+				return m
+			}(),
+		},
+		{
+			name: "invalid query",
+			value: func() pcommon.Map {
+				m := pcommon.NewMap()
+				m.PutStr("scheme", "https")
+				m.PutStr("host", "example.com")
+				m.PutStr("query", "a=b")
+				return m
+			}(),
+		},
+		{
+			name:  "not a map",
+			value: "https://example.com",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := BuildURL[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			})
+			assert.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+	}
+}
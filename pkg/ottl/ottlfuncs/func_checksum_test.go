@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Checksum(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		expectedCRC32 int64
+		expectedFNV   int64
+	}{
+		{
+			name:          "empty",
+			value:         "",
+			expectedCRC32: 0,
+			// 0xcbf29ce484222325, the FNV-1a offset basis
+			expectedFNV: -3750763034362895579,
+		},
+		{
+			name:          "single character",
+			value:         "a",
+			expectedCRC32: 0xe8b7be43,
+			// 0xaf63dc4c8601ec8c
+			expectedFNV: -5808556873153909620,
+		},
+		{
+			name:          "word",
+			value:         "foobar",
+			expectedCRC32: 0x9ef61f95,
+			// 0x85944171f73967e8
+			expectedFNV: -8821353812377114648,
+		},
+		{
+			name:          "check value",
+			value:         "123456789",
+			expectedCRC32: 0xcbf43926,
+			expectedFNV:   0x06d5573923c6cdfc,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+
+			crcFunc, err := CRC32[interface{}](target)
+			require.NoError(t, err)
+			result, err := crcFunc(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedCRC32, result)
+
+			fnvFunc, err := FNV[interface{}](target)
+			require.NoError(t, err)
+			result, err = fnvFunc(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedFNV, result)
+		})
+	}
+}
+
+func Test_Checksum_Error(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(context.Context, interface{}) (interface{}, error) {
+			return int64(42), nil
+		},
+	}
+
+	crcFunc, err := CRC32[interface{}](target)
+	require.NoError(t, err)
+	_, err = crcFunc(nil, nil)
+	assert.EqualError(t, err, "target must be a string but got int64")
+
+	fnvFunc, err := FNV[interface{}](target)
+	require.NoError(t, err)
+	_, err = fnvFunc(nil, nil)
+	assert.EqualError(t, err, "target must be a string but got int64")
+}
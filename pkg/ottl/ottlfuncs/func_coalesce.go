@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// Coalesce factory function returns the first of the values that is not empty. A value is empty if it is nil, an
+// empty string, an empty byte slice or an empty map or slice. An error is returned if all the values are empty.
+func Coalesce[K any](vals []ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	if len(vals) == 0 {
+		return nil, errors.New("at least one value is required")
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		for _, rv := range vals {
+			val, err := rv.Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			if !isEmptyValue(val) {
+				return val, nil
+			}
+		}
+		return nil, errors.New("all values are empty")
+	}, nil
+}
+
+// isEmptyValue reports whether the value is nil, an empty string, an empty byte slice or an empty map or slice,
+// including a pcommon.Value holding one of these.
+func isEmptyValue(val interface{}) bool {
+	switch v := val.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []byte:
+		return len(v) == 0
+	case []string:
+		return len(v) == 0
+	case pcommon.Map:
+		return v.Len() == 0
+	case pcommon.Slice:
+		return v.Len() == 0
+	case pcommon.Value:
+		return isEmptyValue(fromPcommonValue(v))
+	default:
+		return false
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Coalesce(t *testing.T) {
+	attributes := pcommon.NewMap()
+	attributes.PutStr("url.full", "https://example.com/path")
+	attributes.PutStr("empty", "")
+	attributes.PutEmptyMap("empty.map")
+	attributes.PutInt("zero", 0)
+	attributes.PutBool("false", false)
+
+	getAttribute := func(key string) ottl.Getter[interface{}] {
+		return &ottl.StandardGetSetter[interface{}]{
+			Getter: func(context.Context, interface{}) (interface{}, error) {
+				if value, ok := attributes.Get(key); ok {
+					return fromPcommonValue(value), nil
+				}
+				return nil, nil
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		keys     []string
+		expected interface{}
+	}{
+		{
+			name:     "first present",
+			keys:     []string{"url.full", "http.url"},
+			expected: "https://example.com/path",
+		},
+		{
+			name:     "middle present",
+			keys:     []string{"http.url", "url.full", "http.target"},
+			expected: "https://example.com/path",
+		},
+		{
+			name:     "empty values skipped",
+			keys:     []string{"empty", "empty.map", "url.full"},
+			expected: "https://example.com/path",
+		},
+		{
+			name:     "zero int is not empty",
+			keys:     []string{"http.url", "zero", "url.full"},
+			expected: int64(0),
+		},
+		{
+			name:     "false is not empty",
+			keys:     []string{"false", "url.full"},
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var vals []ottl.Getter[interface{}]
+			for _, key := range tt.keys {
+				vals = append(vals, getAttribute(key))
+			}
+			exprFunc, err := Coalesce(vals)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Coalesce_AllEmpty(t *testing.T) {
+	vals := []ottl.Getter[interface{}]{
+		&ottl.StandardGetSetter[interface{}]{
+			Getter: func(context.Context, interface{}) (interface{}, error) {
+				return nil, nil
+			},
+		},
+		&ottl.StandardGetSetter[interface{}]{
+			Getter: func(context.Context, interface{}) (interface{}, error) {
+				return "", nil
+			},
+		},
+		&ottl.StandardGetSetter[interface{}]{
+			Getter: func(context.Context, interface{}) (interface{}, error) {
+				return pcommon.NewSlice(), nil
+			},
+		},
+	}
+	exprFunc, err := Coalesce(vals)
+	require.NoError(t, err)
+	_, err = exprFunc(nil, nil)
+	assert.EqualError(t, err, "all values are empty")
+}
+
+func Test_Coalesce_NoValues(t *testing.T) {
+	_, err := Coalesce[interface{}](nil)
+	assert.EqualError(t, err, "at least one value is required")
+}
@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// Contains factory function returns true if the target slice contains the item, false otherwise.
+// Elements are compared with their types, e.g. the int 1 and the string "1" are not equal.
+func Contains[K any](target ottl.Getter[K], item ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		targetVal, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		slice, ok := targetVal.(pcommon.Slice)
+		if !ok {
+			return nil, fmt.Errorf("target must be a slice but got %T", targetVal)
+		}
+		itemVal, err := item.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		itemValue, err := toPcommonValue(itemVal)
+		if err != nil {
+			return nil, err
+		}
+		return containsValue(slice, itemValue), nil
+	}, nil
+}
+
+// toPcommonValue returns the value retrieved by a Getter as a pcommon.Value.
+func toPcommonValue(val interface{}) (pcommon.Value, error) {
+	switch v := val.(type) {
+	case pcommon.Value:
+		return v, nil
+	case pcommon.Map:
+		value := pcommon.NewValueMap()
+		v.CopyTo(value.Map())
+		return value, nil
+	case pcommon.Slice:
+		value := pcommon.NewValueSlice()
+		v.CopyTo(value.Slice())
+		return value, nil
+	default:
+		value := pcommon.NewValueEmpty()
+		if err := value.FromRaw(val); err != nil {
+			return value, fmt.Errorf("unsupported type %T", val)
+		}
+		return value, nil
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Contains(t *testing.T) {
+	newSlice := func(values ...interface{}) pcommon.Slice {
+		s := pcommon.NewSlice()
+		assert.NoError(t, s.FromRaw(values))
+		return s
+	}
+	nestedMap := pcommon.NewMap()
+	nestedMap.PutStr("env", "prod")
+
+	tests := []struct {
+		name     string
+		target   pcommon.Slice
+		item     interface{}
+		expected bool
+	}{
+		{
+			name:     "string found",
+			target:   newSlice("dev", "prod"),
+			item:     "prod",
+			expected: true,
+		},
+		{
+			name:     "string not found",
+			target:   newSlice("dev", "staging"),
+			item:     "prod",
+			expected: false,
+		},
+		{
+			name:     "int found",
+			target:   newSlice(int64(1), int64(2)),
+			item:     int64(2),
+			expected: true,
+		},
+		{
+			name:     "double found",
+			target:   newSlice(1.5, 2.5),
+			item:     2.5,
+			expected: true,
+		},
+		{
+			name:     "int is not a double",
+			target:   newSlice(1.0, 2.0),
+			item:     int64(1),
+			expected: false,
+		},
+		{
+			name:     "int is not a string",
+			target:   newSlice("1", "2"),
+			item:     int64(1),
+			expected: false,
+		},
+		{
+			name:     "bool found",
+			target:   newSlice(false, true),
+			item:     true,
+			expected: true,
+		},
+		{
+			name:     "nil found",
+			target:   newSlice("a", nil),
+			item:     nil,
+			expected: true,
+		},
+		{
+			name:     "map found",
+			target:   newSlice(map[string]interface{}{"env": "prod"}),
+			item:     nestedMap,
+			expected: true,
+		},
+		{
+			name:     "slice found",
+			target:   newSlice([]interface{}{"a", "b"}),
+			item:     newSlice("a", "b"),
+			expected: true,
+		},
+		{
+			name:     "value found",
+			target:   newSlice("dev", "prod"),
+			item:     pcommon.NewValueStr("prod"),
+			expected: true,
+		},
+		{
+			name:     "empty slice",
+			target:   pcommon.NewSlice(),
+			item:     "prod",
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Contains[interface{}](
+				&ottl.StandardGetSetter[interface{}]{
+					Getter: func(context.Context, interface{}) (interface{}, error) {
+						return tt.target, nil
+					},
+				},
+				&ottl.StandardGetSetter[interface{}]{
+					Getter: func(context.Context, interface{}) (interface{}, error) {
+						return tt.item, nil
+					},
+				},
+			)
+			assert.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Contains_error(t *testing.T) {
+	tests := []struct {
+		name   string
+		target interface{}
+		item   interface{}
+	}{
+		{
+			name:   "target is not a slice",
+			target: "prod",
+			item:   "prod",
+		},
+		{
+			name:   "unsupported item type",
+			target: pcommon.NewSlice(),
+			item:   struct{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Contains[interface{}](
+				&ottl.StandardGetSetter[interface{}]{
+					Getter: func(context.Context, interface{}) (interface{}, error) {
+						return tt.target, nil
+					},
+				},
+				&ottl.StandardGetSetter[interface{}]{
+					Getter: func(context.Context, interface{}) (interface{}, error) {
+						return tt.item, nil
+					},
+				},
+			)
+			assert.NoError(t, err)
+			_, err = exprFunc(nil, nil)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// convertibleUnit is a unit that can be converted to the other units of the same dimension.
+type convertibleUnit struct {
+	dimension string
+	// factor is the size of the unit in the base unit of its dimension
+	factor float64
+}
+
+// convertibleUnits are the UCUM units supported by ConvertUnit.
+var convertibleUnits = map[string]convertibleUnit{
+	"By":   {dimension: "bytes", factor: 1},
+	"KiBy": {dimension: "bytes", factor: 1 << 10},
+	"MiBy": {dimension: "bytes", factor: 1 << 20},
+	"GiBy": {dimension: "bytes", factor: 1 << 30},
+	"ns":   {dimension: "time", factor: 1},
+	"us":   {dimension: "time", factor: 1e3},
+	"ms":   {dimension: "time", factor: 1e6},
+	"s":    {dimension: "time", factor: 1e9},
+}
+
+// ConvertUnit factory function returns the target number converted from one unit to another of the same dimension
+// as a float64, e.g. from "By" to "MiBy". An error is returned if a unit is unknown or the units have different dimensions.
+func ConvertUnit[K any](target ottl.Getter[K], from string, to string) (ottl.ExprFunc[K], error) {
+	fromUnit, ok := convertibleUnits[from]
+	if !ok {
+		return nil, fmt.Errorf("unknown unit %q", from)
+	}
+	toUnit, ok := convertibleUnits[to]
+	if !ok {
+		return nil, fmt.Errorf("unknown unit %q", to)
+	}
+	if fromUnit.dimension != toUnit.dimension {
+		return nil, fmt.Errorf("cannot convert %s from %q to %s %q", fromUnit.dimension, from, toUnit.dimension, to)
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		var value float64
+		switch v := val.(type) {
+		case int64:
+			value = float64(v)
+		case float64:
+			value = v
+		default:
+			return nil, fmt.Errorf("target must be an int64 or float64 but got %T", val)
+		}
+		return value * fromUnit.factor / toUnit.factor, nil
+	}, nil
+}
@@ -0,0 +1,150 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ConvertUnit(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		from     string
+		to       string
+		expected float64
+	}{
+		{
+			name:     "bytes to mebibytes",
+			value:    int64(5 << 20),
+			from:     "By",
+			to:       "MiBy",
+			expected: 5,
+		},
+		{
+			name:     "bytes to kibibytes",
+			value:    int64(1536),
+			from:     "By",
+			to:       "KiBy",
+			expected: 1.5,
+		},
+		{
+			name:     "gibibytes to mebibytes",
+			value:    0.5,
+			from:     "GiBy",
+			to:       "MiBy",
+			expected: 512,
+		},
+		{
+			name:     "same unit",
+			value:    int64(42),
+			from:     "By",
+			to:       "By",
+			expected: 42,
+		},
+		{
+			name:     "nanoseconds to milliseconds",
+			value:    int64(2500000),
+			from:     "ns",
+			to:       "ms",
+			expected: 2.5,
+		},
+		{
+			name:     "seconds to microseconds",
+			value:    1.25,
+			from:     "s",
+			to:       "us",
+			expected: 1250000,
+		},
+		{
+			name:     "milliseconds to seconds",
+			value:    int64(-1500),
+			from:     "ms",
+			to:       "s",
+			expected: -1.5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := ConvertUnit[interface{}](target, tt.from, tt.to)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_ConvertUnit_InvalidUnits(t *testing.T) {
+	tests := []struct {
+		name        string
+		from        string
+		to          string
+		expectedErr string
+	}{
+		{
+			name:        "incompatible units",
+			from:        "By",
+			to:          "ms",
+			expectedErr: `cannot convert bytes from "By" to time "ms"`,
+		},
+		{
+			name:        "unknown from unit",
+			from:        "bytes",
+			to:          "MiBy",
+			expectedErr: `unknown unit "bytes"`,
+		},
+		{
+			name:        "unknown to unit",
+			from:        "s",
+			to:          "h",
+			expectedErr: `unknown unit "h"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return int64(1), nil
+				},
+			}
+			_, err := ConvertUnit[interface{}](target, tt.from, tt.to)
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func Test_ConvertUnit_InvalidTarget(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(context.Context, interface{}) (interface{}, error) {
+			return "1024", nil
+		},
+	}
+	exprFunc, err := ConvertUnit[interface{}](target, "By", "KiBy")
+	require.NoError(t, err)
+	_, err = exprFunc(nil, nil)
+	assert.EqualError(t, err, "target must be an int64 or float64 but got string")
+}
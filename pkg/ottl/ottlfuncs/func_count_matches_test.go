@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CountMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		pattern  string
+		expected int64
+	}{
+		{
+			name:     "zero matches",
+			target:   "all systems nominal",
+			pattern:  `(?i)error|fail`,
+			expected: 0,
+		},
+		{
+			name:     "multiple matches",
+			target:   "ERROR: disk failed, retry failed, error persisted",
+			pattern:  `(?i)error|fail`,
+			expected: 4,
+		},
+		{
+			name:     "overlapping candidates are counted once",
+			target:   "aaaa",
+			pattern:  `aa`,
+			expected: 2,
+		},
+		{
+			name:     "overlapping alternatives",
+			target:   "abcbc",
+			pattern:  `abc|bcb|bc`,
+			expected: 2,
+		},
+		{
+			name:     "empty target",
+			target:   "",
+			pattern:  `x`,
+			expected: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := CountMatches[interface{}](constGetter(tt.target), tt.pattern)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_CountMatches_InvalidPattern(t *testing.T) {
+	_, err := CountMatches[interface{}](constGetter("test"), "(")
+	assert.ErrorContains(t, err, "the pattern supplied to CountMatches is not a valid regexp pattern")
+}
+
+func Test_CountMatches_NotAString(t *testing.T) {
+	exprFunc, err := CountMatches[interface{}](constGetter(int64(1)), "1")
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.EqualError(t, err, "target must be a string but got int64")
+}
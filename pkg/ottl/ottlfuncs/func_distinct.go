@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// Distinct factory function returns a copy of the target slice without the duplicate elements, keeping the first
+// occurrence of each element. Elements are compared with their types, e.g. the int 1 and the string "1" are distinct.
+func Distinct[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		targetVal, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		slice, ok := targetVal.(pcommon.Slice)
+		if !ok {
+			return nil, fmt.Errorf("target must be a slice but got %T", targetVal)
+		}
+
+		result := pcommon.NewSlice()
+		for i := 0; i < slice.Len(); i++ {
+			if !containsValue(result, slice.At(i)) {
+				slice.At(i).CopyTo(result.AppendEmpty())
+			}
+		}
+		return result, nil
+	}, nil
+}
+
+// containsValue returns true if the slice contains an element equal to the value.
+func containsValue(slice pcommon.Slice, value pcommon.Value) bool {
+	for i := 0; i < slice.Len(); i++ {
+		if slice.At(i).Equal(value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Distinct(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []interface{}
+		expected []interface{}
+	}{
+		{
+			name:     "repeated strings",
+			values:   []interface{}{"prod", "eu", "prod", "us", "eu"},
+			expected: []interface{}{"prod", "eu", "us"},
+		},
+		{
+			name:     "repeated ints",
+			values:   []interface{}{int64(3), int64(1), int64(3), int64(2), int64(1)},
+			expected: []interface{}{int64(3), int64(1), int64(2)},
+		},
+		{
+			name:     "repeated doubles",
+			values:   []interface{}{1.5, 1.5, 2.5},
+			expected: []interface{}{1.5, 2.5},
+		},
+		{
+			name:     "mixed types are not conflated",
+			values:   []interface{}{int64(1), "1", 1.0, true, "true", int64(1), "1"},
+			expected: []interface{}{int64(1), "1", 1.0, true, "true"},
+		},
+		{
+			name:     "repeated maps and slices",
+			values:   []interface{}{map[string]interface{}{"a": "b"}, []interface{}{"a"}, map[string]interface{}{"a": "b"}, []interface{}{"a"}, map[string]interface{}{"a": "c"}},
+			expected: []interface{}{map[string]interface{}{"a": "b"}, []interface{}{"a"}, map[string]interface{}{"a": "c"}},
+		},
+		{
+			name:     "repeated nils",
+			values:   []interface{}{nil, "a", nil},
+			expected: []interface{}{nil, "a"},
+		},
+		{
+			name:     "no duplicates",
+			values:   []interface{}{"a", "b"},
+			expected: []interface{}{"a", "b"},
+		},
+		{
+			name:     "empty",
+			values:   []interface{}{},
+			expected: []interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := pcommon.NewSlice()
+			assert.NoError(t, target.FromRaw(tt.values))
+			original := pcommon.NewSlice()
+			target.CopyTo(original)
+
+			exprFunc, err := Distinct[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return target, nil
+				},
+			})
+			assert.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			assert.NoError(t, err)
+
+			expected := pcommon.NewSlice()
+			assert.NoError(t, expected.FromRaw(tt.expected))
+			assert.Equal(t, expected, result)
+			// The target is not modified
+			assert.Equal(t, original, target)
+		})
+	}
+}
+
+func Test_Distinct_error(t *testing.T) {
+	exprFunc, err := Distinct[interface{}](&ottl.StandardGetSetter[interface{}]{
+		Getter: func(context.Context, interface{}) (interface{}, error) {
+			return "not a slice", nil
+		},
+	})
+	assert.NoError(t, err)
+	_, err = exprFunc(nil, nil)
+	assert.Error(t, err)
+}
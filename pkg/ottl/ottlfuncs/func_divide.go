@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"errors"
+	"math"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+var errDivisionByZero = errors.New("division by zero")
+
+// Divide factory function returns the quotient of the left and right numbers. If both numbers are int64, the result
+// is an int64 truncated towards zero, a float64 otherwise. An error is returned if the right number is zero or if
+// the quotient of two int64 overflows.
+func Divide[K any](left ottl.Getter[K], right ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return arithmetic(left, right, func(a, b int64) (int64, error) {
+		if b == 0 {
+			return 0, errDivisionByZero
+		}
+		if a == math.MinInt64 && b == -1 {
+			return 0, errIntegerOverflow
+		}
+		return a / b, nil
+	}, func(a, b float64) (float64, error) {
+		if b == 0 {
+			return 0, errDivisionByZero
+		}
+		return a / b, nil
+	}), nil
+}
@@ -0,0 +1,155 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Double(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{
+			name:     "string",
+			value:    "50.5",
+			expected: float64(50.5),
+		},
+		{
+			name:     "integer string",
+			value:    "50",
+			expected: float64(50),
+		},
+		{
+			name:     "exponent string",
+			value:    "1.5e3",
+			expected: float64(1500),
+		},
+		{
+			name:     "infinity string",
+			value:    "+Inf",
+			expected: math.Inf(1),
+		},
+		{
+			name:     "float64",
+			value:    float64(2.7),
+			expected: float64(2.7),
+		},
+		{
+			name:     "int64",
+			value:    int64(333),
+			expected: float64(333),
+		},
+		{
+			name:     "max int64",
+			value:    int64(math.MaxInt64),
+			expected: float64(math.MaxInt64),
+		},
+		{
+			name:     "true",
+			value:    true,
+			expected: float64(1),
+		},
+		{
+			name:     "false",
+			value:    false,
+			expected: float64(0),
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			expected: nil,
+		},
+		{
+			name:     "some struct",
+			value:    struct{}{},
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Double[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			})
+			assert.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Double_NaN(t *testing.T) {
+	exprFunc, err := Double[interface{}](&ottl.StandardGetSetter[interface{}]{
+		Getter: func(context.Context, interface{}) (interface{}, error) {
+			return "NaN", nil
+		},
+	})
+	assert.NoError(t, err)
+	result, err := exprFunc(nil, nil)
+	assert.NoError(t, err)
+	assert.True(t, math.IsNaN(result.(float64)))
+}
+
+func Test_Double_error(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{
+			name:  "empty string",
+			value: "",
+		},
+		{
+			name:  "not a number string",
+			value: "test",
+		},
+		{
+			name:  "comma decimal separator string",
+			value: "1,5",
+		},
+		{
+			name:  "thousands separator string",
+			value: "1.000,5",
+		},
+		{
+			name:  "overflowing string",
+			value: "1e400",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Double[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			})
+			assert.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+	}
+}
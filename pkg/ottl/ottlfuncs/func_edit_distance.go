@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// EditDistance factory function returns the Levenshtein distance between the a and b strings as an int64,
+// counting insertions, deletions and substitutions of Unicode code points.
+func EditDistance[K any](a ottl.Getter[K], b ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		aStr, bStr, err := getStringPair(ctx, tCtx, a, b)
+		if err != nil {
+			return nil, err
+		}
+		return int64(levenshtein([]rune(aStr), []rune(bStr))), nil
+	}, nil
+}
+
+func getStringPair[K any](ctx context.Context, tCtx K, a ottl.Getter[K], b ottl.Getter[K]) (string, string, error) {
+	aVal, err := a.Get(ctx, tCtx)
+	if err != nil {
+		return "", "", err
+	}
+	aStr, ok := aVal.(string)
+	if !ok {
+		return "", "", fmt.Errorf("a must be a string but got %T", aVal)
+	}
+	bVal, err := b.Get(ctx, tCtx)
+	if err != nil {
+		return "", "", err
+	}
+	bStr, ok := bVal.(string)
+	if !ok {
+		return "", "", fmt.Errorf("b must be a string but got %T", bVal)
+	}
+	return aStr, bStr, nil
+}
+
+// levenshtein computes the edit distance keeping only two rows of the distance matrix.
+func levenshtein(a, b []rune) int {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(minInt(prev[j]+1, curr[j-1]+1), prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,150 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EditDistance(t *testing.T) {
+	tests := []struct {
+		name               string
+		a                  string
+		b                  string
+		expectedDistance   int64
+		expectedSimilarity float64
+	}{
+		{
+			name:               "identical",
+			a:                  "connection refused",
+			b:                  "connection refused",
+			expectedDistance:   0,
+			expectedSimilarity: 1,
+		},
+		{
+			name:               "both empty",
+			a:                  "",
+			b:                  "",
+			expectedDistance:   0,
+			expectedSimilarity: 1,
+		},
+		{
+			name:               "single substitution",
+			a:                  "cat",
+			b:                  "cut",
+			expectedDistance:   1,
+			expectedSimilarity: 1 - 1.0/3,
+		},
+		{
+			name:               "single insertion",
+			a:                  "cat",
+			b:                  "cart",
+			expectedDistance:   1,
+			expectedSimilarity: 0.75,
+		},
+		{
+			name:               "single deletion",
+			a:                  "cart",
+			b:                  "cat",
+			expectedDistance:   1,
+			expectedSimilarity: 0.75,
+		},
+		{
+			name:               "classic example",
+			a:                  "kitten",
+			b:                  "sitting",
+			expectedDistance:   3,
+			expectedSimilarity: 1 - 3.0/7,
+		},
+		{
+			name:               "completely different",
+			a:                  "abc",
+			b:                  "xyz",
+			expectedDistance:   3,
+			expectedSimilarity: 0,
+		},
+		{
+			name:               "one empty",
+			a:                  "",
+			b:                  "abcd",
+			expectedDistance:   4,
+			expectedSimilarity: 0,
+		},
+		{
+			name:               "multibyte characters",
+			a:                  "caf\u00e9",
+			b:                  "cafe",
+			expectedDistance:   1,
+			expectedSimilarity: 0.75,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := constGetter(tt.a)
+			b := constGetter(tt.b)
+
+			distanceFunc, err := EditDistance[interface{}](a, b)
+			require.NoError(t, err)
+			distance, err := distanceFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedDistance, distance)
+
+			similarityFunc, err := Similarity[interface{}](a, b)
+			require.NoError(t, err)
+			similarity, err := similarityFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expectedSimilarity, similarity, 1e-9)
+		})
+	}
+}
+
+func Test_EditDistance_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        interface{}
+		b        interface{}
+		expected string
+	}{
+		{
+			name:     "a not a string",
+			a:        int64(1),
+			b:        "b",
+			expected: "a must be a string but got int64",
+		},
+		{
+			name:     "b not a string",
+			a:        "a",
+			b:        nil,
+			expected: "b must be a string but got <nil>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			distanceFunc, err := EditDistance[interface{}](constGetter(tt.a), constGetter(tt.b))
+			require.NoError(t, err)
+			_, err = distanceFunc(context.Background(), nil)
+			assert.EqualError(t, err, tt.expected)
+
+			similarityFunc, err := Similarity[interface{}](constGetter(tt.a), constGetter(tt.b))
+			require.NoError(t, err)
+			_, err = similarityFunc(context.Background(), nil)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// elapsedUnits are the units supported by Elapsed.
+var elapsedUnits = map[string]time.Duration{
+	"ns": time.Nanosecond,
+	"us": time.Microsecond,
+	"ms": time.Millisecond,
+	"s":  time.Second,
+}
+
+// Elapsed factory function returns the duration from the start time to the end time in the given unit, "ns", "us",
+// "ms" or "s". The duration is an int64 for "ns" and a float64 for the other units. It is negative if the end time is
+// before the start time. An error is returned if either time is the zero time.
+func Elapsed[K any](start ottl.Getter[K], end ottl.Getter[K], unit string) (ottl.ExprFunc[K], error) {
+	unitDuration, ok := elapsedUnits[unit]
+	if !ok {
+		return nil, fmt.Errorf("unknown unit %q", unit)
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		startVal, err := start.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		startTime, err := toTime(startVal)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start: %w", err)
+		}
+		endVal, err := end.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		endTime, err := toTime(endVal)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end: %w", err)
+		}
+		if startTime.IsZero() || endTime.IsZero() {
+			return nil, errors.New("start and end must not be the zero time")
+		}
+		d := endTime.Sub(startTime)
+		if unitDuration == time.Nanosecond {
+			return d.Nanoseconds(), nil
+		}
+		return float64(d) / float64(unitDuration), nil
+	}, nil
+}
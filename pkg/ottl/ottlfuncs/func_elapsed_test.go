@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_Elapsed(t *testing.T) {
+	start := time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC)
+	end := start.Add(1500*time.Millisecond + 250*time.Microsecond)
+
+	tests := []struct {
+		name     string
+		start    interface{}
+		end      interface{}
+		unit     string
+		expected interface{}
+	}{
+		{
+			name:     "nanoseconds",
+			start:    start,
+			end:      end,
+			unit:     "ns",
+			expected: int64(1500250000),
+		},
+		{
+			name:     "microseconds",
+			start:    start,
+			end:      end,
+			unit:     "us",
+			expected: 1500250.0,
+		},
+		{
+			name:     "milliseconds",
+			start:    start,
+			end:      end,
+			unit:     "ms",
+			expected: 1500.25,
+		},
+		{
+			name:     "seconds",
+			start:    start,
+			end:      end,
+			unit:     "s",
+			expected: 1.50025,
+		},
+		{
+			name:     "reversed nanoseconds",
+			start:    end,
+			end:      start,
+			unit:     "ns",
+			expected: int64(-1500250000),
+		},
+		{
+			name:     "reversed milliseconds",
+			start:    end,
+			end:      start,
+			unit:     "ms",
+			expected: -1500.25,
+		},
+		{
+			name:     "equal times",
+			start:    start,
+			end:      start,
+			unit:     "s",
+			expected: 0.0,
+		},
+		{
+			name:     "timestamp and unix nanoseconds",
+			start:    pcommon.NewTimestampFromTime(start),
+			end:      end.UnixNano(),
+			unit:     "ms",
+			expected: 1500.25,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Elapsed[interface{}](constGetter(tt.start), constGetter(tt.end), tt.unit)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Elapsed_Error(t *testing.T) {
+	now := time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		start    interface{}
+		end      interface{}
+		expected string
+	}{
+		{
+			name:     "start is not a time",
+			start:    "2023-01-02",
+			end:      now,
+			expected: "invalid start: target must be a time but got string",
+		},
+		{
+			name:     "end is missing",
+			start:    now,
+			end:      nil,
+			expected: "invalid end: target must be a time but got <nil>",
+		},
+		{
+			name:     "zero start",
+			start:    int64(0),
+			end:      now,
+			expected: "start and end must not be the zero time",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Elapsed[interface{}](constGetter(tt.start), constGetter(tt.end), "ns")
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
+
+func Test_Elapsed_InvalidUnit(t *testing.T) {
+	_, err := Elapsed[interface{}](constGetter(nil), constGetter(nil), "h")
+	assert.EqualError(t, err, `unknown unit "h"`)
+}
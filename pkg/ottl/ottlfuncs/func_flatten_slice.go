@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// FlattenSlice factory function returns a `pcommon.Map` containing the elements of the target slice keyed by their
+// index, e.g. `prefix.0`. Maps and slices are flattened recursively, so the fields of a map in the slice are keyed
+// like `prefix.0.field`. If prefix is empty, the keys start with the index. Empty maps and slices are kept as is.
+func FlattenSlice[K any](target ottl.Getter[K], prefix string) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		targetVal, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		slice, ok := targetVal.(pcommon.Slice)
+		if !ok {
+			return nil, fmt.Errorf("target must be a slice but got %T", targetVal)
+		}
+		result := pcommon.NewMap()
+		flattenSlice(result, prefix, slice)
+		return result, nil
+	}, nil
+}
+
+func flattenSlice(dest pcommon.Map, prefix string, slice pcommon.Slice) {
+	for i := 0; i < slice.Len(); i++ {
+		flattenValue(dest, joinFlattenedKey(prefix, strconv.Itoa(i)), slice.At(i))
+	}
+}
+
+func flattenValue(dest pcommon.Map, key string, v pcommon.Value) {
+	switch {
+	case v.Type() == pcommon.ValueTypeMap && v.Map().Len() > 0:
+		v.Map().Range(func(k string, field pcommon.Value) bool {
+			flattenValue(dest, joinFlattenedKey(key, k), field)
+			return true
+		})
+	case v.Type() == pcommon.ValueTypeSlice && v.Slice().Len() > 0:
+		flattenSlice(dest, key, v.Slice())
+	default:
+		v.CopyTo(dest.PutEmpty(key))
+	}
+}
+
+func joinFlattenedKey(prefix string, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
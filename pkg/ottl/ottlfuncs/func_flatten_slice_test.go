@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_FlattenSlice(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []interface{}
+		prefix   string
+		expected map[string]interface{}
+	}{
+		{
+			name: "heterogeneous maps",
+			input: []interface{}{
+				map[string]interface{}{"name": "db", "port": int64(5432)},
+				map[string]interface{}{"name": "cache", "ttl": 1.5, "enabled": true},
+				map[string]interface{}{},
+			},
+			prefix: "backends",
+			expected: map[string]interface{}{
+				"backends.0.name":    "db",
+				"backends.0.port":    int64(5432),
+				"backends.1.name":    "cache",
+				"backends.1.ttl":     1.5,
+				"backends.1.enabled": true,
+				"backends.2":         map[string]interface{}{},
+			},
+		},
+		{
+			name: "nested maps and slices",
+			input: []interface{}{
+				map[string]interface{}{
+					"user": map[string]interface{}{"id": "u1"},
+					"tags": []interface{}{"a", "b"},
+				},
+			},
+			prefix: "events",
+			expected: map[string]interface{}{
+				"events.0.user.id": "u1",
+				"events.0.tags.0":  "a",
+				"events.0.tags.1":  "b",
+			},
+		},
+		{
+			name:   "scalars and empty prefix",
+			input:  []interface{}{"x", map[string]interface{}{"k": "v"}, nil},
+			prefix: "",
+			expected: map[string]interface{}{
+				"0":   "x",
+				"1.k": "v",
+				"2":   nil,
+			},
+		},
+		{
+			name:     "empty slice",
+			input:    []interface{}{},
+			prefix:   "items",
+			expected: map[string]interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slice := pcommon.NewSlice()
+			require.NoError(t, slice.FromRaw(tt.input))
+			exprFunc, err := FlattenSlice[interface{}](constGetter(slice), tt.prefix)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			resultMap, ok := result.(pcommon.Map)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, resultMap.AsRaw())
+		})
+	}
+}
+
+func Test_FlattenSlice_NotASlice(t *testing.T) {
+	exprFunc, err := FlattenSlice[interface{}](constGetter(pcommon.NewMap()), "prefix")
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.EqualError(t, err, "target must be a slice but got pcommon.Map")
+}
@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// FormatTime factory function returns the target time formatted with the Go reference layout in the IANA location,
+// e.g. "America/New_York". An empty location is UTC. An empty string is returned for the zero time.
+func FormatTime[K any](target ottl.Getter[K], layout string, location string) (ottl.ExprFunc[K], error) {
+	if layout == "" {
+		return nil, errors.New("layout cannot be empty")
+	}
+	loc, err := time.LoadLocation(location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid location %q: %w", location, err)
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		t, err := toTime(val)
+		if err != nil {
+			return nil, err
+		}
+		if t.IsZero() {
+			return "", nil
+		}
+		return t.In(loc).Format(layout), nil
+	}, nil
+}
+
+// toTime returns the time.Time of the value, either a time.Time, a pcommon.Timestamp or an int64 of nanoseconds
+// since the Unix epoch as returned by the time_unix_nano paths. A zero timestamp is the zero time.
+func toTime(val interface{}) (time.Time, error) {
+	switch v := val.(type) {
+	case time.Time:
+		return v, nil
+	case pcommon.Timestamp:
+		if v == 0 {
+			return time.Time{}, nil
+		}
+		return v.AsTime(), nil
+	case int64:
+		if v == 0 {
+			return time.Time{}, nil
+		}
+		return time.Unix(0, v).UTC(), nil
+	default:
+		return time.Time{}, fmt.Errorf("target must be a time but got %T", val)
+	}
+}
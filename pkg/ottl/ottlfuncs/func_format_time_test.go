@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_FormatTime(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	instant := time.Date(2023, 1, 2, 15, 4, 5, 123456789, time.UTC)
+
+	tests := []struct {
+		name     string
+		value    interface{}
+		layout   string
+		location string
+		expected string
+	}{
+		{
+			name:     "time in UTC",
+			value:    instant,
+			layout:   time.RFC3339Nano,
+			location: "",
+			expected: "2023-01-02T15:04:05.123456789Z",
+		},
+		{
+			name:     "time in another location is converted to UTC",
+			value:    instant.In(tokyo),
+			layout:   time.RFC3339,
+			location: "UTC",
+			expected: "2023-01-02T15:04:05Z",
+		},
+		{
+			name:     "time in a named location",
+			value:    instant,
+			layout:   "2006-01-02 15:04:05 MST",
+			location: "Asia/Tokyo",
+			expected: "2023-01-03 00:04:05 JST",
+		},
+		{
+			name:     "timestamp",
+			value:    pcommon.NewTimestampFromTime(instant),
+			layout:   "02/Jan/2006:15:04:05",
+			location: "",
+			expected: "02/Jan/2023:15:04:05",
+		},
+		{
+			name:     "unix nanoseconds",
+			value:    instant.UnixNano(),
+			layout:   time.RFC3339,
+			location: "America/New_York",
+			expected: "2023-01-02T10:04:05-05:00",
+		},
+		{
+			name:     "zero time",
+			value:    time.Time{},
+			layout:   time.RFC3339,
+			location: "",
+			expected: "",
+		},
+		{
+			name:     "zero timestamp",
+			value:    pcommon.Timestamp(0),
+			layout:   time.RFC3339,
+			location: "",
+			expected: "",
+		},
+		{
+			name:     "zero unix nanoseconds",
+			value:    int64(0),
+			layout:   time.RFC3339,
+			location: "",
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := FormatTime[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}, tt.layout, tt.location)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_FormatTime_error(t *testing.T) {
+	exprFunc, err := FormatTime[interface{}](&ottl.StandardGetSetter[interface{}]{
+		Getter: func(context.Context, interface{}) (interface{}, error) {
+			return "2023-01-02T15:04:05Z", nil
+		},
+	}, time.RFC3339, "")
+	require.NoError(t, err)
+	_, err = exprFunc(nil, nil)
+	assert.Error(t, err)
+}
+
+func Test_FormatTime_invalid_arguments(t *testing.T) {
+	_, err := FormatTime[interface{}](&ottl.StandardGetSetter[interface{}]{}, "", "")
+	assert.Error(t, err)
+
+	_, err = FormatTime[interface{}](&ottl.StandardGetSetter[interface{}]{}, time.RFC3339, "Mars/Olympus_Mons")
+	assert.Error(t, err)
+}
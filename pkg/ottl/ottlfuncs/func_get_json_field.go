@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	jsoniter "github.com/json-iterator/go"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// jsonPathSegment is either the key of an object or the index of an array.
+type jsonPathSegment struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+func (s jsonPathSegment) String() string {
+	if s.isIndex {
+		return fmt.Sprintf("[%d]", s.index)
+	}
+	return strconv.Quote(s.key)
+}
+
+// GetJSONField factory function returns the value at the path of the target JSON string, e.g. "items[0].id".
+// Keys containing dots can be quoted in brackets, e.g. `labels["app.kubernetes.io/name"]`.
+// If the path is missing, nil is returned if ignoreMissing is set and an error otherwise.
+// JSON values are converted as done by ParseJSON: numbers are float64, objects are pcommon.Map and arrays are pcommon.Slice.
+func GetJSONField[K any](target ottl.Getter[K], path string, ignoreMissing bool) (ottl.ExprFunc[K], error) {
+	segments, err := compileJSONPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path %q: %w", path, err)
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		jsonStr, ok := val.(string)
+		if !ok {
+			return nil, fmt.Errorf("target must be a string but got %T", val)
+		}
+		var parsed interface{}
+		if err = jsoniter.UnmarshalFromString(jsonStr, &parsed); err != nil {
+			return nil, err
+		}
+
+		current := parsed
+		for _, segment := range segments {
+			var found bool
+			switch v := current.(type) {
+			case map[string]interface{}:
+				if !segment.isIndex {
+					current, found = v[segment.key]
+				}
+			case []interface{}:
+				if segment.isIndex && segment.index < len(v) {
+					current, found = v[segment.index], true
+				}
+			}
+			if !found {
+				if ignoreMissing {
+					return nil, nil
+				}
+				return nil, fmt.Errorf("path %q not found: no %v", path, segment)
+			}
+		}
+
+		switch v := current.(type) {
+		case map[string]interface{}:
+			result := pcommon.NewMap()
+			return result, result.FromRaw(v)
+		case []interface{}:
+			result := pcommon.NewSlice()
+			return result, result.FromRaw(v)
+		default:
+			return v, nil
+		}
+	}, nil
+}
+
+func compileJSONPath(path string) ([]jsonPathSegment, error) {
+	var segments []jsonPathSegment
+	for i := 0; i < len(path); {
+		switch {
+		case path[i] == '[':
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated bracket at offset %d", i)
+			}
+			end += i
+			inner := path[i+1 : end]
+			if len(inner) >= 2 && (inner[0] == '"' || inner[0] == '\'') && inner[len(inner)-1] == inner[0] {
+				segments = append(segments, jsonPathSegment{key: inner[1 : len(inner)-1]})
+			} else {
+				index, err := strconv.Atoi(inner)
+				if err != nil || index < 0 {
+					return nil, fmt.Errorf("invalid array index %q at offset %d", inner, i)
+				}
+				segments = append(segments, jsonPathSegment{index: index, isIndex: true})
+			}
+			i = end + 1
+		case path[i] == '.' && len(segments) == 0:
+			return nil, errors.New("path cannot start with \".\"")
+		default:
+			if path[i] == '.' {
+				i++
+			}
+			end := strings.IndexAny(path[i:], ".[")
+			if end < 0 {
+				end = len(path) - i
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("empty key at offset %d", i)
+			}
+			segments = append(segments, jsonPathSegment{key: path[i : i+end]})
+			i += end
+		}
+	}
+	if len(segments) == 0 {
+		return nil, errors.New("path cannot be empty")
+	}
+	return segments, nil
+}
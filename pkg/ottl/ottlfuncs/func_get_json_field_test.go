@@ -0,0 +1,196 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+const testJSONDocument = `{
+  "order": {"id": 42, "paid": true, "note": null},
+  "items": [
+    {"id": "a1", "tags": ["new", "sale"]},
+    {"id": "b2", "tags": []}
+  ],
+  "labels": {"app.kubernetes.io/name": "shop"},
+  "matrix": [[1, 2], [3, 4]]
+}`
+
+func Test_GetJSONField(t *testing.T) {
+	expectedOrder := pcommon.NewMap()
+	expectedOrder.PutDouble("id", 42)
+	expectedOrder.PutBool("paid", true)
+	expectedOrder.PutEmpty("note")
+	expectedTags := pcommon.NewSlice()
+	expectedTags.AppendEmpty().SetStr("new")
+	expectedTags.AppendEmpty().SetStr("sale")
+
+	tests := []struct {
+		name     string
+		path     string
+		expected interface{}
+	}{
+		{
+			name:     "nested object",
+			path:     "order.id",
+			expected: float64(42),
+		},
+		{
+			name:     "bool",
+			path:     "order.paid",
+			expected: true,
+		},
+		{
+			name:     "null",
+			path:     "order.note",
+			expected: nil,
+		},
+		{
+			name:     "object",
+			path:     "order",
+			expected: expectedOrder,
+		},
+		{
+			name:     "array index",
+			path:     "items[0].id",
+			expected: "a1",
+		},
+		{
+			name:     "array",
+			path:     "items[0].tags",
+			expected: expectedTags,
+		},
+		{
+			name:     "nested array index",
+			path:     "items[0].tags[1]",
+			expected: "sale",
+		},
+		{
+			name:     "array of arrays",
+			path:     "matrix[1][0]",
+			expected: float64(3),
+		},
+		{
+			name:     "quoted key",
+			path:     `labels["app.kubernetes.io/name"]`,
+			expected: "shop",
+		},
+		{
+			name:     "single quoted key",
+			path:     `['order'].id`,
+			expected: float64(42),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := GetJSONField[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return testJSONDocument, nil
+				},
+			}, tt.path, false)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			require.NoError(t, err)
+			// The order of the keys of parsed objects is not deterministic
+			if expected, ok := tt.expected.(pcommon.Map); ok {
+				require.IsType(t, pcommon.Map{}, result)
+				assert.Equal(t, expected.AsRaw(), result.(pcommon.Map).AsRaw())
+				return
+			}
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_GetJSONField_missing(t *testing.T) {
+	tests := []struct {
+		name        string
+		path        string
+		expectedErr string
+	}{
+		{
+			name:        "missing key",
+			path:        "order.customer",
+			expectedErr: `path "order.customer" not found: no "customer"`,
+		},
+		{
+			name:        "index out of range",
+			path:        "items[2].id",
+			expectedErr: `path "items[2].id" not found: no [2]`,
+		},
+		{
+			name:        "index of an object",
+			path:        "order[0]",
+			expectedErr: `path "order[0]" not found: no [0]`,
+		},
+		{
+			name:        "key of an array",
+			path:        "items.id",
+			expectedErr: `path "items.id" not found: no "id"`,
+		},
+		{
+			name:        "key of a scalar",
+			path:        "order.id.value",
+			expectedErr: `path "order.id.value" not found: no "value"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getter := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return testJSONDocument, nil
+				},
+			}
+
+			exprFunc, err := GetJSONField[interface{}](getter, tt.path, false)
+			require.NoError(t, err)
+			_, err = exprFunc(nil, nil)
+			assert.EqualError(t, err, tt.expectedErr)
+
+			exprFunc, err = GetJSONField[interface{}](getter, tt.path, true)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			assert.NoError(t, err)
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func Test_GetJSONField_error(t *testing.T) {
+	for _, value := range []interface{}{`{"order": `, "not json", int64(1)} {
+		exprFunc, err := GetJSONField[interface{}](&ottl.StandardGetSetter[interface{}]{
+			Getter: func(context.Context, interface{}) (interface{}, error) {
+				return value, nil
+			},
+		}, "order", true)
+		require.NoError(t, err)
+		_, err = exprFunc(nil, nil)
+		assert.Error(t, err, value)
+	}
+}
+
+func Test_GetJSONField_invalid_path(t *testing.T) {
+	for _, path := range []string{"", ".order", "order.", "order..id", "items[", "items[-1]", "items[a]", "items.[0]"} {
+		_, err := GetJSONField[interface{}](&ottl.StandardGetSetter[interface{}]{}, path, false)
+		assert.Error(t, err, path)
+	}
+}
@@ -0,0 +1,336 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+var xpathNameRegexp = regexp.MustCompile(`^(\*|[A-Za-z_][\w.\-]*)`)
+
+// xpathStep is a location step of an XPath, e.g. "//item[2]" or "/@id".
+type xpathStep struct {
+	// descendant selects the descendants of the context nodes instead of their children
+	descendant bool
+	// attribute selects the attributes of the context nodes instead of their child elements
+	attribute bool
+	// name is the local name of the selected elements or attributes, "*" selects all of them
+	name       string
+	predicates []xpathPredicate
+}
+
+// xpathPredicate filters the elements selected by a step, either by their 1-based position or by an attribute.
+type xpathPredicate struct {
+	position  int
+	attribute string
+	value     string
+	hasValue  bool
+}
+
+// xmlNode is an element of a parsed XML document.
+type xmlNode struct {
+	name     string
+	attrs    []xml.Attr
+	children []*xmlNode
+	// text is the concatenated character data of the element and its descendants
+	text strings.Builder
+}
+
+// GetXML factory function evaluates the xpath against the target XML string and returns the text of the matching
+// elements or the values of the matching attributes, with surrounding whitespace trimmed. A string is returned for a
+// single match, a pcommon.Slice for multiple matches and nil if nothing matches.
+//
+// The following subset of XPath is supported:
+//
+//	/name       -> the child elements with the local name, "*" matches any name
+//	//name      -> the descendant elements with the local name
+//	/@name      -> the attributes with the local name, only as the last step
+//	[n]         -> the nth element selected by the step, starting at 1
+//	[@name]     -> the elements selected by the step that have the attribute
+//	[@name='v'] -> the elements selected by the step whose attribute has the value
+func GetXML[K any](target ottl.Getter[K], xpath string) (ottl.ExprFunc[K], error) {
+	steps, err := compileXPath(xpath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid xpath %q: %w", xpath, err)
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		doc, ok := val.(string)
+		if !ok {
+			return nil, fmt.Errorf("target must be a string but got %T", val)
+		}
+		root, err := parseXMLTree(doc)
+		if err != nil {
+			return nil, err
+		}
+
+		matches := evaluateXPath(root, steps)
+		switch len(matches) {
+		case 0:
+			return nil, nil
+		case 1:
+			return matches[0], nil
+		default:
+			result := pcommon.NewSlice()
+			result.EnsureCapacity(len(matches))
+			for _, match := range matches {
+				result.AppendEmpty().SetStr(match)
+			}
+			return result, nil
+		}
+	}, nil
+}
+
+func compileXPath(xpath string) ([]xpathStep, error) {
+	if !strings.HasPrefix(xpath, "/") {
+		return nil, errors.New("only absolute paths starting with \"/\" are supported")
+	}
+	var steps []xpathStep
+	for i := 0; i < len(xpath); {
+		if len(steps) > 0 && steps[len(steps)-1].attribute {
+			return nil, errors.New("attributes can only be selected by the last step")
+		}
+
+		var step xpathStep
+		switch {
+		case strings.HasPrefix(xpath[i:], "//"):
+			step.descendant = true
+			i += 2
+		case xpath[i] == '/':
+			i++
+		default:
+			return nil, fmt.Errorf("unexpected %q at offset %d", xpath[i], i)
+		}
+		if i < len(xpath) && xpath[i] == '@' {
+			step.attribute = true
+			i++
+		}
+		step.name = xpathNameRegexp.FindString(xpath[i:])
+		if step.name == "" {
+			return nil, fmt.Errorf("expected a name at offset %d", i)
+		}
+		i += len(step.name)
+
+		for i < len(xpath) && xpath[i] == '[' {
+			end := closingBracketIndex(xpath, i)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated predicate at offset %d", i)
+			}
+			predicate, err := compileXPathPredicate(xpath[i+1 : end])
+			if err != nil {
+				return nil, err
+			}
+			step.predicates = append(step.predicates, predicate)
+			i = end + 1
+		}
+		if step.attribute && len(step.predicates) > 0 {
+			return nil, errors.New("predicates are not supported on attributes")
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+// closingBracketIndex returns the index of the "]" closing the "[" at the start index, ignoring quoted brackets.
+func closingBracketIndex(xpath string, start int) int {
+	var quote byte
+	for i := start + 1; i < len(xpath); i++ {
+		switch {
+		case quote != 0:
+			if xpath[i] == quote {
+				quote = 0
+			}
+		case xpath[i] == '\'' || xpath[i] == '"':
+			quote = xpath[i]
+		case xpath[i] == ']':
+			return i
+		}
+	}
+	return -1
+}
+
+func compileXPathPredicate(expr string) (xpathPredicate, error) {
+	expr = strings.TrimSpace(expr)
+	if position, err := strconv.Atoi(expr); err == nil {
+		if position < 1 {
+			return xpathPredicate{}, fmt.Errorf("position must be at least 1 but got %d", position)
+		}
+		return xpathPredicate{position: position}, nil
+	}
+	if !strings.HasPrefix(expr, "@") {
+		return xpathPredicate{}, fmt.Errorf("unsupported predicate %q", expr)
+	}
+	name := xpathNameRegexp.FindString(expr[1:])
+	if name == "" || name == "*" {
+		return xpathPredicate{}, fmt.Errorf("unsupported predicate %q", expr)
+	}
+	predicate := xpathPredicate{attribute: name}
+	rest := strings.TrimSpace(expr[1+len(name):])
+	if rest == "" {
+		return predicate, nil
+	}
+	if !strings.HasPrefix(rest, "=") {
+		return xpathPredicate{}, fmt.Errorf("unsupported predicate %q", expr)
+	}
+	value := strings.TrimSpace(rest[1:])
+	if len(value) < 2 || (value[0] != '\'' && value[0] != '"') || value[len(value)-1] != value[0] {
+		return xpathPredicate{}, fmt.Errorf("the value of predicate %q must be quoted", expr)
+	}
+	predicate.value = value[1 : len(value)-1]
+	predicate.hasValue = true
+	return predicate, nil
+}
+
+// parseXMLTree returns a node whose only child is the root element of the XML document.
+func parseXMLTree(doc string) (*xmlNode, error) {
+	decoder := xml.NewDecoder(strings.NewReader(doc))
+	document := &xmlNode{}
+	stack := []*xmlNode{document}
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid XML: %w", err)
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			node := &xmlNode{name: t.Name.Local, attrs: t.Attr}
+			parent := stack[len(stack)-1]
+			parent.children = append(parent.children, node)
+			stack = append(stack, node)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			for _, node := range stack[1:] {
+				node.text.Write(t)
+			}
+		}
+	}
+	if len(document.children) == 0 {
+		return nil, errors.New("invalid XML: no root element")
+	}
+	return document, nil
+}
+
+func evaluateXPath(document *xmlNode, steps []xpathStep) []string {
+	nodes := []*xmlNode{document}
+	for _, step := range steps {
+		if step.attribute {
+			return selectXMLAttributes(nodes, step)
+		}
+		var selected []*xmlNode
+		seen := make(map[*xmlNode]bool)
+		for _, node := range nodes {
+			parents := []*xmlNode{node}
+			if step.descendant {
+				parents = descendantsOrSelf(node)
+			}
+			for _, parent := range parents {
+				for _, match := range selectXMLChildren(parent, step) {
+					if !seen[match] {
+						seen[match] = true
+						selected = append(selected, match)
+					}
+				}
+			}
+		}
+		nodes = selected
+	}
+
+	texts := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		texts = append(texts, strings.TrimSpace(node.text.String()))
+	}
+	return texts
+}
+
+func selectXMLAttributes(nodes []*xmlNode, step xpathStep) []string {
+	var values []string
+	for _, node := range nodes {
+		elements := []*xmlNode{node}
+		if step.descendant {
+			elements = descendantsOrSelf(node)
+		}
+		for _, element := range elements {
+			for _, attr := range element.attrs {
+				if step.name == "*" || attr.Name.Local == step.name {
+					values = append(values, strings.TrimSpace(attr.Value))
+				}
+			}
+		}
+	}
+	return values
+}
+
+// selectXMLChildren returns the children of the parent matching the name and the predicates of the step.
+func selectXMLChildren(parent *xmlNode, step xpathStep) []*xmlNode {
+	var matches []*xmlNode
+	for _, child := range parent.children {
+		if step.name == "*" || child.name == step.name {
+			matches = append(matches, child)
+		}
+	}
+	for _, predicate := range step.predicates {
+		if predicate.position > 0 {
+			if predicate.position > len(matches) {
+				return nil
+			}
+			matches = matches[predicate.position-1 : predicate.position]
+			continue
+		}
+		var filtered []*xmlNode
+		for _, match := range matches {
+			if value, ok := xmlAttribute(match, predicate.attribute); ok && (!predicate.hasValue || value == predicate.value) {
+				filtered = append(filtered, match)
+			}
+		}
+		matches = filtered
+	}
+	return matches
+}
+
+func xmlAttribute(node *xmlNode, name string) (string, bool) {
+	for _, attr := range node.attrs {
+		if attr.Name.Local == name {
+			return attr.Value, true
+		}
+	}
+	return "", false
+}
+
+// descendantsOrSelf returns the node and all its descendants in document order.
+func descendantsOrSelf(node *xmlNode) []*xmlNode {
+	nodes := []*xmlNode{node}
+	for _, child := range node.children {
+		nodes = append(nodes, descendantsOrSelf(child)...)
+	}
+	return nodes
+}
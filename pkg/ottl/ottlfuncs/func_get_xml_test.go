@@ -0,0 +1,200 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+const testXMLDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<order id="42" status="shipped">
+  <customer>
+    <name>Jane Doe</name>
+    <address type="billing"><city>Paris</city></address>
+    <address type="shipping"><city>Lyon</city></address>
+  </customer>
+  <items>
+    <item sku="a1"><name>Book</name><price currency="EUR">12.50</price></item>
+    <item sku="b2"><name>Pen</name><price currency="EUR">1.20</price></item>
+  </items>
+  <note><![CDATA[Leave at <door>]]></note>
+</order>`
+
+func Test_GetXML(t *testing.T) {
+	tests := []struct {
+		name     string
+		xpath    string
+		expected interface{}
+	}{
+		{
+			name:     "element",
+			xpath:    "/order/customer/name",
+			expected: "Jane Doe",
+		},
+		{
+			name:     "attribute",
+			xpath:    "/order/@status",
+			expected: "shipped",
+		},
+		{
+			name:     "nested element",
+			xpath:    "/order/items/item/price",
+			expected: newTestXMLSlice("12.50", "1.20"),
+		},
+		{
+			name:     "descendant element",
+			xpath:    "//city",
+			expected: newTestXMLSlice("Paris", "Lyon"),
+		},
+		{
+			name:     "descendant attribute",
+			xpath:    "//item/@sku",
+			expected: newTestXMLSlice("a1", "b2"),
+		},
+		{
+			name:     "nested descendant",
+			xpath:    "/order//item/name",
+			expected: newTestXMLSlice("Book", "Pen"),
+		},
+		{
+			name:     "wildcard",
+			xpath:    "/order/customer/*/city",
+			expected: newTestXMLSlice("Paris", "Lyon"),
+		},
+		{
+			name:     "position",
+			xpath:    "/order/items/item[2]/name",
+			expected: "Pen",
+		},
+		{
+			name:     "attribute value",
+			xpath:    "//address[@type='shipping']/city",
+			expected: "Lyon",
+		},
+		{
+			name:     "attribute value with double quotes",
+			xpath:    "//item[@sku=\"a1\"]/price/@currency",
+			expected: "EUR",
+		},
+		{
+			name:     "attribute existence",
+			xpath:    "/*[@id]/@id",
+			expected: "42",
+		},
+		{
+			name:     "text of nested elements",
+			xpath:    "/order/items/item[1]",
+			expected: "Book12.50",
+		},
+		{
+			name:     "CDATA",
+			xpath:    "/order/note",
+			expected: "Leave at <door>",
+		},
+		{
+			name:     "no match",
+			xpath:    "/order/missing",
+			expected: nil,
+		},
+		{
+			name:     "position out of range",
+			xpath:    "/order/items/item[3]",
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := GetXML[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return testXMLDocument, nil
+				},
+			}, tt.xpath)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_GetXML_error(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{
+			name:  "unclosed element",
+			value: "<order><id>42</id>",
+		},
+		{
+			name:  "mismatched element",
+			value: "<order><id>42</order></id>",
+		},
+		{
+			name:  "not XML",
+			value: "order id 42",
+		},
+		{
+			name:  "not a string",
+			value: int64(42),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := GetXML[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}, "/order/id")
+			require.NoError(t, err)
+			_, err = exprFunc(nil, nil)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func Test_GetXML_invalid_xpath(t *testing.T) {
+	for _, xpath := range []string{
+		"",
+		"order/id",
+		"/order/",
+		"/order/@id/name",
+		"/order/@id[1]",
+		"/order/item[0]",
+		"/order/item[last()]",
+		"/order/item[@sku=a1]",
+		"/order/item[@sku='a1'",
+		"/order/text()",
+		"/order | /item",
+	} {
+		_, err := GetXML[interface{}](&ottl.StandardGetSetter[interface{}]{}, xpath)
+		assert.Error(t, err, xpath)
+	}
+}
+
+func newTestXMLSlice(values ...string) pcommon.Slice {
+	s := pcommon.NewSlice()
+	for _, v := range values {
+		s.AppendEmpty().SetStr(v)
+	}
+	return s
+}
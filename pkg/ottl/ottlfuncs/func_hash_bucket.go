@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// HashBucket factory function returns the bucket between 0 and buckets-1 of the target as an int64, computed from the
+// 64-bit FNV-1a hash of the target. The target is either a string or bytes, such as a trace ID or a span ID.
+func HashBucket[K any](target ottl.Getter[K], buckets int64) (ottl.ExprFunc[K], error) {
+	if buckets < 1 {
+		return nil, fmt.Errorf("buckets must be positive but got %d", buckets)
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		var data []byte
+		switch v := val.(type) {
+		case string:
+			data = []byte(v)
+		case []byte:
+			data = v
+		case pcommon.TraceID:
+			data = v[:]
+		case pcommon.SpanID:
+			data = v[:]
+		default:
+			return nil, fmt.Errorf("unsupported type %T", val)
+		}
+		hash := fnv.New64a()
+		_, _ = hash.Write(data)
+		return int64(hash.Sum64() % uint64(buckets)), nil
+	}, nil
+}
@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_HashBucket(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		buckets  int64
+		expected int64
+	}{
+		{
+			// The 64-bit FNV-1a hash of the empty string is its offset basis 14695981039346656037
+			name:     "empty string",
+			value:    "",
+			buckets:  1000,
+			expected: 37,
+		},
+		{
+			// The 64-bit FNV-1a hash of "a" is 12638187200555641996
+			name:     "string",
+			value:    "a",
+			buckets:  1000,
+			expected: 996,
+		},
+		{
+			name:     "bytes hash like the equivalent string",
+			value:    []byte("a"),
+			buckets:  1000,
+			expected: 996,
+		},
+		{
+			name:     "single bucket",
+			value:    "4bf92f3577b34da6a3ce929d0e0e4736",
+			buckets:  1,
+			expected: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := HashBucket[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}, tt.buckets)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_HashBucket_deterministic(t *testing.T) {
+	traceID := pcommon.TraceID([16]byte{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36})
+	for _, value := range []interface{}{"4bf92f3577b34da6a3ce929d0e0e4736", traceID, pcommon.SpanID([8]byte{0, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7})} {
+		var buckets []interface{}
+		for i := 0; i < 3; i++ {
+			exprFunc, err := HashBucket[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return value, nil
+				},
+			}, 16)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			require.NoError(t, err)
+			buckets = append(buckets, result)
+		}
+		assert.Equal(t, buckets[0], buckets[1])
+		assert.Equal(t, buckets[0], buckets[2])
+	}
+}
+
+func Test_HashBucket_uniform(t *testing.T) {
+	const buckets = 10
+	const inputs = 10000
+	var value string
+	exprFunc, err := HashBucket[interface{}](&ottl.StandardGetSetter[interface{}]{
+		Getter: func(context.Context, interface{}) (interface{}, error) {
+			return value, nil
+		},
+	}, buckets)
+	require.NoError(t, err)
+
+	counts := make([]int, buckets)
+	for i := 0; i < inputs; i++ {
+		value = fmt.Sprintf("trace-%d", i)
+		result, err := exprFunc(nil, nil)
+		require.NoError(t, err)
+		bucket := result.(int64)
+		require.True(t, bucket >= 0 && bucket < buckets, "bucket %d out of range", bucket)
+		counts[bucket]++
+	}
+	// Each bucket is expected to get 1000 inputs, allow a 20% deviation
+	for bucket, count := range counts {
+		assert.InDelta(t, inputs/buckets, count, inputs/buckets*0.2, "bucket %d", bucket)
+	}
+}
+
+func Test_HashBucket_error(t *testing.T) {
+	exprFunc, err := HashBucket[interface{}](&ottl.StandardGetSetter[interface{}]{
+		Getter: func(context.Context, interface{}) (interface{}, error) {
+			return int64(42), nil
+		},
+	}, 10)
+	require.NoError(t, err)
+	_, err = exprFunc(nil, nil)
+	assert.Error(t, err)
+
+	for _, buckets := range []int64{0, -1} {
+		_, err = HashBucket[interface{}](&ottl.StandardGetSetter[interface{}]{}, buckets)
+		assert.Error(t, err, buckets)
+	}
+}
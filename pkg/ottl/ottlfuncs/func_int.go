@@ -16,11 +16,18 @@ package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-c
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
 	"strconv"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 )
 
+// Int factory function returns the target converted to an int64.
+// Strings are parsed as ints, falling back to parsing them as floats that are truncated towards zero.
+// An error is returned if a string can't be parsed or if a string or float64 is NaN or out of the int64 range.
+// Bools are converted to 1 or 0, other types return nil.
 func Int[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
 	return func(ctx context.Context, tCtx K) (interface{}, error) {
 		value, err := target.Get(ctx, tCtx)
@@ -32,13 +39,25 @@ func Int[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
 			return value, nil
 		case string:
 			intValue, err := strconv.ParseInt(value, 10, 64)
-			if err != nil {
-				return nil, nil
+			if err == nil {
+				return intValue, nil
 			}
-
-			return intValue, nil
+			if errors.Is(err, strconv.ErrRange) {
+				return nil, fmt.Errorf("unable to convert string %q to int: %w", value, err)
+			}
+			floatValue, floatErr := strconv.ParseFloat(value, 64)
+			if floatErr != nil {
+				return nil, fmt.Errorf("unable to convert string %q to int: %w", value, err)
+			}
+			if !inInt64Range(floatValue) {
+				return nil, fmt.Errorf("unable to convert string %q to int: value out of range", value)
+			}
+			return int64(floatValue), nil
 		case float64:
-			return (int64)(value), nil
+			if !inInt64Range(value) {
+				return nil, fmt.Errorf("unable to convert %v to int: value out of range", value)
+			}
+			return int64(value), nil
 		case bool:
 			if value {
 				return int64(1), nil
@@ -49,3 +68,8 @@ func Int[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
 		}
 	}, nil
 }
+
+// inInt64Range reports whether the given float truncated towards zero can be represented as an int64.
+func inInt64Range(value float64) bool {
+	return !math.IsNaN(value) && value < math.MaxInt64 && value >= math.MinInt64
+}
@@ -16,6 +16,7 @@ package ottlfuncs
 
 import (
 	"context"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -35,14 +36,19 @@ func Test_Int(t *testing.T) {
 			expected: int64(50),
 		},
 		{
-			name:     "empty string",
-			value:    "",
-			expected: nil,
+			name:     "float string",
+			value:    "2.7",
+			expected: int64(2),
 		},
 		{
-			name:     "not a number string",
-			value:    "test",
-			expected: nil,
+			name:     "negative float string",
+			value:    "-2.7",
+			expected: int64(-2),
+		},
+		{
+			name:     "exponent string",
+			value:    "1e3",
+			expected: int64(1000),
 		},
 		{
 			name:     "int64",
@@ -94,3 +100,64 @@ func Test_Int(t *testing.T) {
 		})
 	}
 }
+
+func Test_Int_error(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{
+			name:  "empty string",
+			value: "",
+		},
+		{
+			name:  "not a number string",
+			value: "test",
+		},
+		{
+			name:  "NaN string",
+			value: "NaN",
+		},
+		{
+			name:  "comma decimal separator string",
+			value: "1,5",
+		},
+		{
+			name:  "overflowing string",
+			value: "9223372036854775808",
+		},
+		{
+			name:  "underflowing string",
+			value: "-9223372036854775809",
+		},
+		{
+			name:  "overflowing float64",
+			value: math.MaxFloat64,
+		},
+		{
+			name:  "overflowing float64 at boundary",
+			value: float64(math.MaxInt64),
+		},
+		{
+			name:  "NaN float64",
+			value: math.NaN(),
+		},
+		{
+			name:  "infinite float64",
+			value: math.Inf(-1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Int[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			})
+			assert.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_IsEmpty(t *testing.T) {
+	nonEmptyMap := pcommon.NewMap()
+	nonEmptyMap.PutStr("k", "v")
+	nonEmptySlice := pcommon.NewSlice()
+	nonEmptySlice.AppendEmpty().SetStr("v")
+
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected bool
+	}{
+		{"nil", nil, true},
+		{"empty string", "", true},
+		{"string", "a", false},
+		{"whitespace string", " ", false},
+		{"zero int", int64(0), false},
+		{"zero double", float64(0), false},
+		{"false", false, false},
+		{"empty bytes", []byte{}, true},
+		{"bytes", []byte{1}, false},
+		{"empty map", pcommon.NewMap(), true},
+		{"map", nonEmptyMap, false},
+		{"empty slice", pcommon.NewSlice(), true},
+		{"slice", nonEmptySlice, false},
+		{"empty string slice", []string{}, true},
+		{"string slice", []string{""}, false},
+		{"empty value", pcommon.NewValueEmpty(), true},
+		{"empty string value", pcommon.NewValueStr(""), true},
+		{"string value", pcommon.NewValueStr("a"), false},
+		{"int value", pcommon.NewValueInt(0), false},
+		{"double value", pcommon.NewValueDouble(0), false},
+		{"bool value", pcommon.NewValueBool(false), false},
+		{"empty map value", pcommon.NewValueMap(), true},
+		{"empty slice value", pcommon.NewValueSlice(), true},
+		{"empty bytes value", pcommon.NewValueBytes(), true},
+		{"unsupported type", struct{}{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := IsEmpty[interface{}](constGetter(tt.value))
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// IsIPInRange factory function returns true if the target IP address belongs to any of the IPv4 or IPv6 CIDR
+// ranges, false otherwise. Targets that are not valid IP addresses never result in an error and return false.
+func IsIPInRange[K any](target ottl.Getter[K], cidrs []string) (ottl.ExprFunc[K], error) {
+	if len(cidrs) == 0 {
+		return nil, errors.New("at least one CIDR range is required")
+	}
+	ranges := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR range %q: %w", cidr, err)
+		}
+		ranges = append(ranges, ipNet)
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		ipStr, ok := val.(string)
+		if !ok {
+			return false, nil
+		}
+		ip := net.ParseIP(ipStr)
+		if ip == nil {
+			return false, nil
+		}
+		for _, ipNet := range ranges {
+			if ipNet.Contains(ip) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}, nil
+}
@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_IsIPInRange(t *testing.T) {
+	privateRanges := []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "fd00::/8"}
+
+	tests := []struct {
+		name     string
+		value    interface{}
+		cidrs    []string
+		expected bool
+	}{
+		{
+			name:     "IPv4 in range",
+			value:    "10.1.2.3",
+			cidrs:    privateRanges,
+			expected: true,
+		},
+		{
+			name:     "IPv4 in second range",
+			value:    "172.20.0.1",
+			cidrs:    privateRanges,
+			expected: true,
+		},
+		{
+			name:     "IPv4 out of range",
+			value:    "8.8.8.8",
+			cidrs:    privateRanges,
+			expected: false,
+		},
+		{
+			name:     "IPv4 first address of range",
+			value:    "172.16.0.0",
+			cidrs:    []string{"172.16.0.0/12"},
+			expected: true,
+		},
+		{
+			name:     "IPv4 last address of range",
+			value:    "172.31.255.255",
+			cidrs:    []string{"172.16.0.0/12"},
+			expected: true,
+		},
+		{
+			name:     "IPv4 just below range",
+			value:    "172.15.255.255",
+			cidrs:    []string{"172.16.0.0/12"},
+			expected: false,
+		},
+		{
+			name:     "IPv4 just above range",
+			value:    "172.32.0.0",
+			cidrs:    []string{"172.16.0.0/12"},
+			expected: false,
+		},
+		{
+			name:     "IPv4 single address range",
+			value:    "192.0.2.1",
+			cidrs:    []string{"192.0.2.1/32"},
+			expected: true,
+		},
+		{
+			name:     "IPv4 mapped IPv6 address",
+			value:    "::ffff:10.0.0.1",
+			cidrs:    privateRanges,
+			expected: true,
+		},
+		{
+			name:     "IPv6 in range",
+			value:    "fd12:3456:789a::1",
+			cidrs:    privateRanges,
+			expected: true,
+		},
+		{
+			name:     "IPv6 out of range",
+			value:    "2001:db8::1",
+			cidrs:    privateRanges,
+			expected: false,
+		},
+		{
+			name:     "IPv6 last address of range",
+			value:    "2001:db8:ffff:ffff:ffff:ffff:ffff:ffff",
+			cidrs:    []string{"2001:db8::/32"},
+			expected: true,
+		},
+		{
+			name:     "IPv6 just above range",
+			value:    "2001:db9::",
+			cidrs:    []string{"2001:db8::/32"},
+			expected: false,
+		},
+		{
+			name:     "IPv6 address in IPv4 range",
+			value:    "fd00::1",
+			cidrs:    []string{"0.0.0.0/0"},
+			expected: false,
+		},
+		{
+			name:     "invalid IP",
+			value:    "10.0.0.256",
+			cidrs:    privateRanges,
+			expected: false,
+		},
+		{
+			name:     "IP with port",
+			value:    "10.0.0.1:8080",
+			cidrs:    privateRanges,
+			expected: false,
+		},
+		{
+			name:     "empty string",
+			value:    "",
+			cidrs:    privateRanges,
+			expected: false,
+		},
+		{
+			name:     "not a string",
+			value:    int64(167772161),
+			cidrs:    privateRanges,
+			expected: false,
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			cidrs:    privateRanges,
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := IsIPInRange[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}, tt.cidrs)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_IsIPInRange_invalid_cidrs(t *testing.T) {
+	for _, cidrs := range [][]string{nil, {"10.0.0.0"}, {"10.0.0.0/33"}, {"10.0.0.0/8", "fd00::/129"}, {"not a range"}} {
+		_, err := IsIPInRange[interface{}](&ottl.StandardGetSetter[interface{}]{}, cidrs)
+		assert.Error(t, err, cidrs)
+	}
+}
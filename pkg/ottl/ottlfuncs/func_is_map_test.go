@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_IsMap(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected bool
+	}{
+		{
+			name:     "string",
+			value:    "a string",
+			expected: false,
+		},
+		{
+			name:     "empty string",
+			value:    "",
+			expected: false,
+		},
+		{
+			name:     "bool",
+			value:    true,
+			expected: false,
+		},
+		{
+			name:     "int64",
+			value:    int64(1),
+			expected: false,
+		},
+		{
+			name:     "float64",
+			value:    1.5,
+			expected: false,
+		},
+		{
+			name:     "bytes",
+			value:    []byte{1, 2},
+			expected: false,
+		},
+		{
+			name:     "map",
+			value:    pcommon.NewMap(),
+			expected: true,
+		},
+		{
+			name:     "slice",
+			value:    pcommon.NewSlice(),
+			expected: false,
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			expected: false,
+		},
+		{
+			name:     "string value",
+			value:    pcommon.NewValueStr("a string"),
+			expected: false,
+		},
+		{
+			name:     "bool value",
+			value:    pcommon.NewValueBool(true),
+			expected: false,
+		},
+		{
+			name:     "int value",
+			value:    pcommon.NewValueInt(1),
+			expected: false,
+		},
+		{
+			name:     "double value",
+			value:    pcommon.NewValueDouble(1.5),
+			expected: false,
+		},
+		{
+			name:     "bytes value",
+			value:    pcommon.NewValueBytes(),
+			expected: false,
+		},
+		{
+			name:     "map value",
+			value:    pcommon.NewValueMap(),
+			expected: true,
+		},
+		{
+			name:     "slice value",
+			value:    pcommon.NewValueSlice(),
+			expected: false,
+		},
+		{
+			name:     "empty value",
+			value:    pcommon.NewValueEmpty(),
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := IsMap[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			})
+			assert.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_IsValidJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected bool
+	}{
+		{
+			name:     "object",
+			value:    `{"test":"string value","nested":{"list":[1,2.5,true,null]}}`,
+			expected: true,
+		},
+		{
+			name:     "empty object",
+			value:    "{}",
+			expected: true,
+		},
+		{
+			name:     "array",
+			value:    `["a", 1, {"b": false}]`,
+			expected: true,
+		},
+		{
+			name:     "string scalar",
+			value:    `"a string"`,
+			expected: true,
+		},
+		{
+			name:     "number scalar",
+			value:    "-1.5e3",
+			expected: true,
+		},
+		{
+			name:     "boolean scalar",
+			value:    "true",
+			expected: true,
+		},
+		{
+			name:     "null",
+			value:    "null",
+			expected: true,
+		},
+		{
+			name:     "surrounding whitespace",
+			value:    " {\"a\": 1}\n",
+			expected: true,
+		},
+		{
+			name:     "garbage",
+			value:    "not json",
+			expected: false,
+		},
+		{
+			name:     "unterminated object",
+			value:    `{"test":"string value"`,
+			expected: false,
+		},
+		{
+			name:     "trailing comma",
+			value:    `{"a": 1,}`,
+			expected: false,
+		},
+		{
+			name:     "trailing data",
+			value:    `{"a": 1} {"b": 2}`,
+			expected: false,
+		},
+		{
+			name:     "single quotes",
+			value:    `{'a': 1}`,
+			expected: false,
+		},
+		{
+			name:     "empty string",
+			value:    "",
+			expected: false,
+		},
+		{
+			name:     "not a string",
+			value:    int64(1),
+			expected: false,
+		},
+		{
+			name:     "map",
+			value:    pcommon.NewMap(),
+			expected: false,
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := IsValidJSON[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			})
+			assert.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
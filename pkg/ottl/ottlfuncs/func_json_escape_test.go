@@ -0,0 +1,193 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_JSONEscape(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		expected string
+	}{
+		{
+			name:     "plain",
+			target:   "hello world",
+			expected: "hello world",
+		},
+		{
+			name:     "quotes and backslashes",
+			target:   `say "hi" \ bye`,
+			expected: `say \"hi\" \\ bye`,
+		},
+		{
+			name:     "control characters",
+			target:   "a\nb\tc\rd\x00e\x1f",
+			expected: `a\nb\tc\rd\u0000e\u001f`,
+		},
+		{
+			name:     "html is not escaped",
+			target:   "<a href='x'>&</a>",
+			expected: "<a href='x'>&</a>",
+		},
+		{
+			name:     "unicode",
+			target:   "h\u00e9llo \u4e16\u754c \u2028",
+			expected: "h\u00e9llo \u4e16\u754c \\u2028",
+		},
+		{
+			name:     "invalid utf-8",
+			target:   "a\xffb",
+			expected: "a\ufffdb",
+		},
+		{
+			name:     "empty",
+			target:   "",
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.target, nil
+				},
+			}
+			exprFunc, err := JSONEscape[interface{}](target)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_JSONUnescape(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		expected string
+	}{
+		{
+			name:     "plain",
+			target:   "hello world",
+			expected: "hello world",
+		},
+		{
+			name:     "quotes and backslashes",
+			target:   `say \"hi\" \\ bye \/`,
+			expected: `say "hi" \ bye /`,
+		},
+		{
+			name:     "control characters",
+			target:   `a\nb\tc\rd\u0000e\b\f`,
+			expected: "a\nb\tc\rd\x00e\b\f",
+		},
+		{
+			name:     "unicode escapes",
+			target:   `caf\u00e9 \u4e16\u754c`,
+			expected: "caf\u00e9 \u4e16\u754c",
+		},
+		{
+			name:     "surrogate pair",
+			target:   `\ud83d\ude00`,
+			expected: "\U0001F600",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.target, nil
+				},
+			}
+			exprFunc, err := JSONUnescape[interface{}](target)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_JSONUnescape_Error(t *testing.T) {
+	tests := []struct {
+		name   string
+		target interface{}
+	}{
+		{
+			name:   "unescaped quote",
+			target: `say "hi"`,
+		},
+		{
+			name:   "invalid escape",
+			target: `\x41`,
+		},
+		{
+			name:   "truncated unicode escape",
+			target: `\u00`,
+		},
+		{
+			name:   "raw control character",
+			target: "a\nb",
+		},
+		{
+			name:   "not a string",
+			target: int64(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.target, nil
+				},
+			}
+			exprFunc, err := JSONUnescape[interface{}](target)
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func Test_JSONEscape_RoundTrip(t *testing.T) {
+	original := "line1\n\"quoted\"\t\\path\\ \u0001 \u00fcn\u00efc\u00f6d\u00e9"
+	escapeFunc, err := JSONEscape[interface{}](ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+			return original, nil
+		},
+	})
+	require.NoError(t, err)
+	escaped, err := escapeFunc(context.Background(), nil)
+	require.NoError(t, err)
+	unescapeFunc, err := JSONUnescape[interface{}](ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+			return escaped, nil
+		},
+	})
+	require.NoError(t, err)
+	result, err := unescapeFunc(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, original, result)
+}
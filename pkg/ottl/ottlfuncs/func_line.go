@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// Line factory function returns the line at the index of the target string, split on `\n` with any trailing `\r`
+// removed from the line. Negative indexes count from the end, e.g. -1 is the last line. A newline at the end of
+// the target terminates the last line rather than starting an empty one.
+func Line[K any](target ottl.Getter[K], index int64) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		str, ok := val.(string)
+		if !ok {
+			return nil, fmt.Errorf("target must be a string but got %T", val)
+		}
+		lines := strings.Split(strings.TrimSuffix(str, "\n"), "\n")
+		i := index
+		if i < 0 {
+			i += int64(len(lines))
+		}
+		if i < 0 || i >= int64(len(lines)) {
+			return nil, fmt.Errorf("line index %d out of range for string with %d lines", index, len(lines))
+		}
+		return strings.TrimSuffix(lines[i], "\r"), nil
+	}, nil
+}
@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Line(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		index    int64
+		expected string
+	}{
+		{
+			name:     "first line LF",
+			target:   "panic: oops\ngoroutine 1\nmain.main()",
+			index:    0,
+			expected: "panic: oops",
+		},
+		{
+			name:     "first line CRLF",
+			target:   "panic: oops\r\ngoroutine 1\r\nmain.main()",
+			index:    0,
+			expected: "panic: oops",
+		},
+		{
+			name:     "middle line CRLF",
+			target:   "a\r\nb\r\nc",
+			index:    1,
+			expected: "b",
+		},
+		{
+			name:     "last line with trailing LF",
+			target:   "a\nb\n",
+			index:    -1,
+			expected: "b",
+		},
+		{
+			name:     "last line with trailing CRLF",
+			target:   "a\r\nb\r\n",
+			index:    -1,
+			expected: "b",
+		},
+		{
+			name:     "negative index",
+			target:   "a\nb\nc",
+			index:    -3,
+			expected: "a",
+		},
+		{
+			name:     "empty line",
+			target:   "a\n\nc",
+			index:    1,
+			expected: "",
+		},
+		{
+			name:     "single line",
+			target:   "only line",
+			index:    0,
+			expected: "only line",
+		},
+		{
+			name:     "lone carriage return is kept inside a line",
+			target:   "a\rb\nc",
+			index:    0,
+			expected: "a\rb",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Line[interface{}](constGetter(tt.target), tt.index)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Line_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   interface{}
+		index    int64
+		expected string
+	}{
+		{
+			name:     "index too large",
+			target:   "a\nb",
+			index:    2,
+			expected: "line index 2 out of range for string with 2 lines",
+		},
+		{
+			name:     "negative index too small",
+			target:   "a\r\nb\r\n",
+			index:    -3,
+			expected: "line index -3 out of range for string with 2 lines",
+		},
+		{
+			name:     "not a string",
+			target:   int64(1),
+			index:    0,
+			expected: "target must be a string but got int64",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Line[interface{}](constGetter(tt.target), tt.index)
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
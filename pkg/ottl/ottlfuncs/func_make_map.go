@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// MakeMap factory function returns a new `pcommon.Map` built from the alternating keys and values of pairs. The keys
+// are converted to strings like the target of Map, and the values are copied keeping their types. A later pair
+// replaces an earlier pair with the same key. An error is returned if the number of pairs is odd.
+func MakeMap[K any](pairs []ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	if len(pairs)%2 != 0 {
+		return nil, fmt.Errorf("pairs must have an even number of keys and values but got %d", len(pairs))
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		result := pcommon.NewMap()
+		result.EnsureCapacity(len(pairs) / 2)
+		for i := 0; i < len(pairs); i += 2 {
+			keyVal, err := pairs[i].Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			key, ok, err := mappingKey(keyVal)
+			if err != nil {
+				return nil, fmt.Errorf("key at index %d %w", i, err)
+			}
+			if !ok {
+				return nil, fmt.Errorf("key at index %d must not be nil", i)
+			}
+			val, err := pairs[i+1].Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			v, err := toPcommonValue(val)
+			if err != nil {
+				return nil, fmt.Errorf("value at index %d: %w", i+1, err)
+			}
+			v.CopyTo(result.PutEmpty(key))
+		}
+		return result, nil
+	}, nil
+}
@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func getters(values ...interface{}) []ottl.Getter[interface{}] {
+	result := make([]ottl.Getter[interface{}], len(values))
+	for i, value := range values {
+		result[i] = constGetter(value)
+	}
+	return result
+}
+
+func Test_MakeMap(t *testing.T) {
+	nested := pcommon.NewSlice()
+	nested.AppendEmpty().SetStr("a")
+
+	tests := []struct {
+		name     string
+		pairs    []interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:  "string keys",
+			pairs: []interface{}{"service", "checkout", "port", int64(8080), "tags", nested},
+			expected: map[string]interface{}{
+				"service": "checkout",
+				"port":    int64(8080),
+				"tags":    []interface{}{"a"},
+			},
+		},
+		{
+			name:  "stringified keys",
+			pairs: []interface{}{int64(200), "OK", 1.5, "ratio", true, "yes"},
+			expected: map[string]interface{}{
+				"200":  "OK",
+				"1.5":  "ratio",
+				"true": "yes",
+			},
+		},
+		{
+			name:  "duplicate key",
+			pairs: []interface{}{"level", "info", "level", "debug"},
+			expected: map[string]interface{}{
+				"level": "debug",
+			},
+		},
+		{
+			name:  "nil value",
+			pairs: []interface{}{"user", nil},
+			expected: map[string]interface{}{
+				"user": nil,
+			},
+		},
+		{
+			name:     "no pairs",
+			pairs:    []interface{}{},
+			expected: map[string]interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := MakeMap[interface{}](getters(tt.pairs...))
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			m, ok := result.(pcommon.Map)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, m.AsRaw())
+		})
+	}
+}
+
+func Test_MakeMap_OddCount(t *testing.T) {
+	_, err := MakeMap[interface{}](getters("service", "checkout", "port"))
+	assert.EqualError(t, err, "pairs must have an even number of keys and values but got 3")
+}
+
+func Test_MakeMap_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		pairs    []interface{}
+		expected string
+	}{
+		{
+			name:     "nil key",
+			pairs:    []interface{}{"a", int64(1), nil, int64(2)},
+			expected: "key at index 2 must not be nil",
+		},
+		{
+			name:     "map key",
+			pairs:    []interface{}{pcommon.NewMap(), int64(1)},
+			expected: "key at index 0 must be a string, int64, float64 or bool but got pcommon.Map",
+		},
+		{
+			name:     "unsupported value",
+			pairs:    []interface{}{"trace", pcommon.NewTraceIDEmpty()},
+			expected: "value at index 1: unsupported type pcommon.TraceID",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := MakeMap[interface{}](getters(tt.pairs...))
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
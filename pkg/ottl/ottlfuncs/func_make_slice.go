@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// MakeSlice factory function returns a new `pcommon.Slice` containing a copy of each of the values in order, keeping
+// their types. A nil value, e.g. a missing attribute, is added as an empty value.
+func MakeSlice[K any](values []ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		result := pcommon.NewSlice()
+		result.EnsureCapacity(len(values))
+		for i, value := range values {
+			val, err := value.Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			v, err := toPcommonValue(val)
+			if err != nil {
+				return nil, fmt.Errorf("value at index %d: %w", i, err)
+			}
+			v.CopyTo(result.AppendEmpty())
+		}
+		return result, nil
+	}, nil
+}
@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_MakeSlice(t *testing.T) {
+	nested := pcommon.NewMap()
+	nested.PutStr("name", "alice")
+	nested.PutEmptyMap("address").PutStr("city", "Paris")
+	list := pcommon.NewSlice()
+	list.AppendEmpty().SetStr("a")
+	list.AppendEmpty().SetInt(1)
+
+	tests := []struct {
+		name     string
+		values   []interface{}
+		expected []interface{}
+	}{
+		{
+			name:   "strings, ints and nested maps",
+			values: []interface{}{"a", int64(1), nested, 1.5, true},
+			expected: []interface{}{
+				"a",
+				int64(1),
+				map[string]interface{}{
+					"name": "alice",
+					"address": map[string]interface{}{
+						"city": "Paris",
+					},
+				},
+				1.5,
+				true,
+			},
+		},
+		{
+			name:     "nested slice and bytes",
+			values:   []interface{}{list, []byte{1, 2}},
+			expected: []interface{}{[]interface{}{"a", int64(1)}, []byte{1, 2}},
+		},
+		{
+			name:     "pcommon values",
+			values:   []interface{}{pcommon.NewValueStr("b"), pcommon.NewValueInt(2)},
+			expected: []interface{}{"b", int64(2)},
+		},
+		{
+			name:     "nil value",
+			values:   []interface{}{"a", nil},
+			expected: []interface{}{"a", nil},
+		},
+		{
+			name:     "no values",
+			values:   []interface{}{},
+			expected: []interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getters := make([]ottl.Getter[interface{}], len(tt.values))
+			for i, value := range tt.values {
+				getters[i] = constGetter(value)
+			}
+			exprFunc, err := MakeSlice[interface{}](getters)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			slice, ok := result.(pcommon.Slice)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, slice.AsRaw())
+		})
+	}
+}
+
+func Test_MakeSlice_CopiesValues(t *testing.T) {
+	nested := pcommon.NewMap()
+	nested.PutStr("name", "alice")
+	exprFunc, err := MakeSlice[interface{}]([]ottl.Getter[interface{}]{constGetter(nested)})
+	require.NoError(t, err)
+	result, err := exprFunc(context.Background(), nil)
+	require.NoError(t, err)
+
+	nested.PutStr("name", "bob")
+	assert.Equal(t, []interface{}{map[string]interface{}{"name": "alice"}}, result.(pcommon.Slice).AsRaw())
+}
+
+func Test_MakeSlice_Error(t *testing.T) {
+	exprFunc, err := MakeSlice[interface{}]([]ottl.Getter[interface{}]{constGetter("a"), constGetter(pcommon.NewTraceIDEmpty())})
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.EqualError(t, err, "value at index 1: unsupported type pcommon.TraceID")
+}
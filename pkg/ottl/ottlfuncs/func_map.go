@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// Map factory function returns the value of the mappings `pcommon.Map` whose key is the string representation of
+// the target value, or the target value itself if there is no such key.
+func Map[K any](target ottl.Getter[K], mappings ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return mapValue(target, mappings, nil), nil
+}
+
+// MapWithDefault factory function returns the value of the mappings `pcommon.Map` whose key is the string
+// representation of the target value, or the default value if there is no such key.
+func MapWithDefault[K any](target ottl.Getter[K], mappings ottl.Getter[K], defaultValue ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return mapValue(target, mappings, defaultValue), nil
+}
+
+// mapValue looks up the target value in mappings, falling back to defaultValue, or to the target value if
+// defaultValue is nil.
+func mapValue[K any](target ottl.Getter[K], mappings ottl.Getter[K], defaultValue ottl.Getter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		targetVal, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		mappingsVal, err := mappings.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		m, ok := mappingsVal.(pcommon.Map)
+		if !ok {
+			return nil, fmt.Errorf("mappings must be a map but got %T", mappingsVal)
+		}
+		key, ok, err := mappingKey(targetVal)
+		if err != nil {
+			return nil, fmt.Errorf("target %w", err)
+		}
+		if ok {
+			if v, exists := m.Get(key); exists {
+				return fromPcommonValue(v), nil
+			}
+		}
+		if defaultValue == nil {
+			return targetVal, nil
+		}
+		return defaultValue.Get(ctx, tCtx)
+	}
+}
+
+// mappingKey returns the string representation of a scalar value used as a key of the mappings. It returns false if
+// the value is nil, which never matches a key.
+func mappingKey(val interface{}) (string, bool, error) {
+	switch v := val.(type) {
+	case nil:
+		return "", false, nil
+	case string:
+		return v, true, nil
+	case int64:
+		return strconv.FormatInt(v, 10), true, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true, nil
+	case bool:
+		return strconv.FormatBool(v), true, nil
+	default:
+		return "", false, fmt.Errorf("must be a string, int64, float64 or bool but got %T", val)
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_Map(t *testing.T) {
+	mappings := pcommon.NewMap()
+	require.NoError(t, mappings.FromRaw(map[string]interface{}{
+		"200":   "OK",
+		"404":   "Not Found",
+		"1.5":   "one and a half",
+		"true":  "yes",
+		"debug": int64(5),
+	}))
+
+	tests := []struct {
+		name     string
+		target   interface{}
+		expected interface{}
+	}{
+		{
+			name:     "int hit",
+			target:   int64(404),
+			expected: "Not Found",
+		},
+		{
+			name:     "string hit",
+			target:   "200",
+			expected: "OK",
+		},
+		{
+			name:     "double hit",
+			target:   1.5,
+			expected: "one and a half",
+		},
+		{
+			name:     "bool hit",
+			target:   true,
+			expected: "yes",
+		},
+		{
+			name:     "non-string mapped value",
+			target:   "debug",
+			expected: int64(5),
+		},
+		{
+			name:     "miss returns original value",
+			target:   int64(500),
+			expected: int64(500),
+		},
+		{
+			name:     "nil returns nil",
+			target:   nil,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Map[interface{}](constGetter(tt.target), constGetter(mappings))
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_MapWithDefault(t *testing.T) {
+	mappings := pcommon.NewMap()
+	require.NoError(t, mappings.FromRaw(map[string]interface{}{
+		"200": "OK",
+	}))
+
+	tests := []struct {
+		name     string
+		target   interface{}
+		expected interface{}
+	}{
+		{
+			name:     "hit",
+			target:   int64(200),
+			expected: "OK",
+		},
+		{
+			name:     "miss returns default",
+			target:   int64(500),
+			expected: "unknown",
+		},
+		{
+			name:     "nil returns default",
+			target:   nil,
+			expected: "unknown",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := MapWithDefault[interface{}](constGetter(tt.target), constGetter(mappings), constGetter("unknown"))
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Map_Error(t *testing.T) {
+	mappings := pcommon.NewMap()
+
+	tests := []struct {
+		name     string
+		target   interface{}
+		mappings interface{}
+		expected string
+	}{
+		{
+			name:     "mappings is not a map",
+			target:   int64(200),
+			mappings: `{"200":"OK"}`,
+			expected: "mappings must be a map but got string",
+		},
+		{
+			name:     "target is a map",
+			target:   pcommon.NewMap(),
+			mappings: mappings,
+			expected: "target must be a string, int64, float64 or bool but got pcommon.Map",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Map[interface{}](constGetter(tt.target), constGetter(tt.mappings))
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
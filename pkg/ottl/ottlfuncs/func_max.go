@@ -0,0 +1,34 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"math"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// Max factory function returns the larger of the left and right numbers. The result is an int64 if both numbers are
+// int64, a float64 otherwise. The result is NaN if either float64 is NaN.
+func Max[K any](left ottl.Getter[K], right ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return arithmetic(left, right, func(a, b int64) (int64, error) {
+		if b > a {
+			return b, nil
+		}
+		return a, nil
+	}, func(a, b float64) (float64, error) {
+		return math.Max(a, b), nil
+	}), nil
+}
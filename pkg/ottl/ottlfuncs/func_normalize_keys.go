@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+var keyStyles = map[string]func(string) string{
+	"snake_case": strcase.ToSnake,
+	// Splitting the key into words first handles acronyms, e.g. HTTPStatus becomes httpStatus rather than httpstatus.
+	"camelCase": func(s string) string {
+		return strcase.ToLowerCamel(strcase.ToSnake(s))
+	},
+}
+
+// NormalizeKeys factory function returns a copy of the target map whose keys are converted to the style, either
+// "snake_case" or "camelCase". Each dot-separated segment of a key is converted separately, so namespaces such as
+// "http." are kept. If recursive is true, the keys of nested maps, including maps in slices, are converted as well.
+// It returns an error if two keys of the same map are converted to the same key.
+func NormalizeKeys[K any](target ottl.Getter[K], style string, recursive bool) (ottl.ExprFunc[K], error) {
+	convert, ok := keyStyles[style]
+	if !ok {
+		return nil, fmt.Errorf("invalid style %q, allowed styles are: snake_case, camelCase", style)
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		targetVal, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		m, ok := targetVal.(pcommon.Map)
+		if !ok {
+			return nil, fmt.Errorf("target must be a map but got %T", targetVal)
+		}
+		result := pcommon.NewMap()
+		if err = normalizeMapKeys(m, result, convert, recursive); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}, nil
+}
+
+func normalizeMapKeys(m pcommon.Map, dest pcommon.Map, convert func(string) string, recursive bool) error {
+	dest.EnsureCapacity(m.Len())
+	originalKeys := make(map[string]string, m.Len())
+	var err error
+	m.Range(func(k string, v pcommon.Value) bool {
+		segments := strings.Split(k, ".")
+		for i, segment := range segments {
+			segments[i] = convert(segment)
+		}
+		key := strings.Join(segments, ".")
+		if original, exists := originalKeys[key]; exists {
+			err = fmt.Errorf("keys %q and %q both normalize to %q", original, k, key)
+			return false
+		}
+		originalKeys[key] = k
+		err = normalizeValueKeys(v, dest.PutEmpty(key), convert, recursive)
+		return err == nil
+	})
+	return err
+}
+
+func normalizeValueKeys(v pcommon.Value, dest pcommon.Value, convert func(string) string, recursive bool) error {
+	if !recursive {
+		v.CopyTo(dest)
+		return nil
+	}
+	switch v.Type() {
+	case pcommon.ValueTypeMap:
+		return normalizeMapKeys(v.Map(), dest.SetEmptyMap(), convert, recursive)
+	case pcommon.ValueTypeSlice:
+		slice := v.Slice()
+		destSlice := dest.SetEmptySlice()
+		destSlice.EnsureCapacity(slice.Len())
+		for i := 0; i < slice.Len(); i++ {
+			if err := normalizeValueKeys(slice.At(i), destSlice.AppendEmpty(), convert, recursive); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		v.CopyTo(dest)
+		return nil
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_NormalizeKeys(t *testing.T) {
+	newInput := func() pcommon.Map {
+		m := pcommon.NewMap()
+		require.NoError(t, m.FromRaw(map[string]interface{}{
+			"userId":            "u1",
+			"request_method":    "GET",
+			"HTTPStatusCode":    int64(200),
+			"http.responseSize": int64(512),
+			"clientInfo": map[string]interface{}{
+				"remoteAddr": "10.0.0.1",
+				"user_agent": "curl",
+			},
+			"retryAttempts": []interface{}{
+				map[string]interface{}{"attemptNumber": int64(1)},
+				"plainValue",
+			},
+		}))
+		return m
+	}
+
+	tests := []struct {
+		name      string
+		style     string
+		recursive bool
+		expected  map[string]interface{}
+	}{
+		{
+			name:      "snake_case",
+			style:     "snake_case",
+			recursive: false,
+			expected: map[string]interface{}{
+				"user_id":            "u1",
+				"request_method":     "GET",
+				"http_status_code":   int64(200),
+				"http.response_size": int64(512),
+				"client_info": map[string]interface{}{
+					"remoteAddr": "10.0.0.1",
+					"user_agent": "curl",
+				},
+				"retry_attempts": []interface{}{
+					map[string]interface{}{"attemptNumber": int64(1)},
+					"plainValue",
+				},
+			},
+		},
+		{
+			name:      "camelCase",
+			style:     "camelCase",
+			recursive: false,
+			expected: map[string]interface{}{
+				"userId":            "u1",
+				"requestMethod":     "GET",
+				"httpStatusCode":    int64(200),
+				"http.responseSize": int64(512),
+				"clientInfo": map[string]interface{}{
+					"remoteAddr": "10.0.0.1",
+					"user_agent": "curl",
+				},
+				"retryAttempts": []interface{}{
+					map[string]interface{}{"attemptNumber": int64(1)},
+					"plainValue",
+				},
+			},
+		},
+		{
+			name:      "snake_case recursive",
+			style:     "snake_case",
+			recursive: true,
+			expected: map[string]interface{}{
+				"user_id":            "u1",
+				"request_method":     "GET",
+				"http_status_code":   int64(200),
+				"http.response_size": int64(512),
+				"client_info": map[string]interface{}{
+					"remote_addr": "10.0.0.1",
+					"user_agent":  "curl",
+				},
+				"retry_attempts": []interface{}{
+					map[string]interface{}{"attempt_number": int64(1)},
+					"plainValue",
+				},
+			},
+		},
+		{
+			name:      "camelCase recursive",
+			style:     "camelCase",
+			recursive: true,
+			expected: map[string]interface{}{
+				"userId":            "u1",
+				"requestMethod":     "GET",
+				"httpStatusCode":    int64(200),
+				"http.responseSize": int64(512),
+				"clientInfo": map[string]interface{}{
+					"remoteAddr": "10.0.0.1",
+					"userAgent":  "curl",
+				},
+				"retryAttempts": []interface{}{
+					map[string]interface{}{"attemptNumber": int64(1)},
+					"plainValue",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := newInput()
+			exprFunc, err := NormalizeKeys[interface{}](constGetter(input), tt.style, tt.recursive)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			resultMap, ok := result.(pcommon.Map)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, resultMap.AsRaw())
+			// The target is not modified
+			assert.Equal(t, newInput().AsRaw(), input.AsRaw())
+		})
+	}
+}
+
+func Test_NormalizeKeys_Collision(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     map[string]interface{}
+		recursive bool
+	}{
+		{
+			name:  "top level",
+			input: map[string]interface{}{"fooBar": "a", "foo_bar": "b"},
+		},
+		{
+			name: "nested",
+			input: map[string]interface{}{
+				"outer": map[string]interface{}{"fooBar": "a", "foo_bar": "b"},
+			},
+			recursive: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := pcommon.NewMap()
+			require.NoError(t, m.FromRaw(tt.input))
+			exprFunc, err := NormalizeKeys[interface{}](constGetter(m), "snake_case", tt.recursive)
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.ErrorContains(t, err, `normalize to "foo_bar"`)
+		})
+	}
+}
+
+func Test_NormalizeKeys_Error(t *testing.T) {
+	_, err := NormalizeKeys[interface{}](constGetter(pcommon.NewMap()), "kebab-case", false)
+	assert.EqualError(t, err, `invalid style "kebab-case", allowed styles are: snake_case, camelCase`)
+
+	exprFunc, err := NormalizeKeys[interface{}](constGetter("not a map"), "snake_case", false)
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.EqualError(t, err, "target must be a map but got string")
+}
@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// Omit factory function returns a new `pcommon.Map` containing a copy of the entries of the target map whose
+// keys are not listed in keys. Listed keys missing from the target are ignored.
+func Omit[K any](target ottl.Getter[K], keys []string) (ottl.ExprFunc[K], error) {
+	keySet := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		keySet[key] = struct{}{}
+	}
+
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		targetVal, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		m, ok := targetVal.(pcommon.Map)
+		if !ok {
+			return nil, fmt.Errorf("target must be a map but got %T", targetVal)
+		}
+		result := pcommon.NewMap()
+		result.EnsureCapacity(m.Len())
+		m.Range(func(key string, v pcommon.Value) bool {
+			if _, omitted := keySet[key]; !omitted {
+				v.CopyTo(result.PutEmpty(key))
+			}
+			return true
+		})
+		return result, nil
+	}, nil
+}
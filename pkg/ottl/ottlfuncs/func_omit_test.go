@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_Omit(t *testing.T) {
+	raw := map[string]interface{}{
+		"http.method": "GET",
+		"password":    "hunter2",
+		"user":        map[string]interface{}{"id": "u1"},
+	}
+
+	tests := []struct {
+		name     string
+		keys     []string
+		expected map[string]interface{}
+	}{
+		{
+			name: "present keys",
+			keys: []string{"password", "user"},
+			expected: map[string]interface{}{
+				"http.method": "GET",
+			},
+		},
+		{
+			name: "absent keys",
+			keys: []string{"missing", "other"},
+			expected: map[string]interface{}{
+				"http.method": "GET",
+				"password":    "hunter2",
+				"user":        map[string]interface{}{"id": "u1"},
+			},
+		},
+		{
+			name: "present and absent keys",
+			keys: []string{"password", "missing"},
+			expected: map[string]interface{}{
+				"http.method": "GET",
+				"user":        map[string]interface{}{"id": "u1"},
+			},
+		},
+		{
+			name:     "all keys",
+			keys:     []string{"http.method", "password", "user"},
+			expected: map[string]interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := pcommon.NewMap()
+			require.NoError(t, input.FromRaw(raw))
+			exprFunc, err := Omit[interface{}](constGetter(input), tt.keys)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			resultMap, ok := result.(pcommon.Map)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, resultMap.AsRaw())
+			// The original map is untouched
+			assert.Equal(t, raw, input.AsRaw())
+		})
+	}
+}
+
+func Test_Omit_CopiesValues(t *testing.T) {
+	input := pcommon.NewMap()
+	input.PutEmptyMap("user").PutStr("id", "u1")
+
+	exprFunc, err := Omit[interface{}](constGetter(input), []string{})
+	require.NoError(t, err)
+	result, err := exprFunc(context.Background(), nil)
+	require.NoError(t, err)
+
+	user, _ := result.(pcommon.Map).Get("user")
+	user.Map().PutStr("id", "u2")
+	original, _ := input.Get("user")
+	assert.Equal(t, map[string]interface{}{"id": "u1"}, original.Map().AsRaw())
+}
+
+func Test_Omit_NotAMap(t *testing.T) {
+	exprFunc, err := Omit[interface{}](constGetter(int64(1)), []string{"a"})
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.EqualError(t, err, "target must be a map but got int64")
+}
@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// PadLeft factory function returns the target string padded on the left with the padChar rune up to length runes.
+// Strings of at least length runes are returned unchanged.
+func PadLeft[K any](target ottl.Getter[K], length int64, padChar string) (ottl.ExprFunc[K], error) {
+	return pad(target, length, padChar, true)
+}
+
+// PadRight factory function returns the target string padded on the right with the padChar rune up to length runes.
+// Strings of at least length runes are returned unchanged.
+func PadRight[K any](target ottl.Getter[K], length int64, padChar string) (ottl.ExprFunc[K], error) {
+	return pad(target, length, padChar, false)
+}
+
+func pad[K any](target ottl.Getter[K], length int64, padChar string, left bool) (ottl.ExprFunc[K], error) {
+	if length < 0 {
+		return nil, fmt.Errorf("length must be non-negative but got %d", length)
+	}
+	if utf8.RuneCountInString(padChar) != 1 {
+		return nil, fmt.Errorf("pad character must be a single character but got %q", padChar)
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		str, ok := val.(string)
+		if !ok {
+			return nil, fmt.Errorf("target must be a string but got %T", val)
+		}
+		missing := length - int64(utf8.RuneCountInString(str))
+		if missing <= 0 {
+			return str, nil
+		}
+		padding := strings.Repeat(padChar, int(missing))
+		if left {
+			return padding + str, nil
+		}
+		return str + padding, nil
+	}, nil
+}
@@ -0,0 +1,172 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Pad(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		length        int64
+		padChar       string
+		expectedLeft  string
+		expectedRight string
+	}{
+		{
+			name:          "zero-padding a number",
+			value:         "42",
+			length:        6,
+			padChar:       "0",
+			expectedLeft:  "000042",
+			expectedRight: "420000",
+		},
+		{
+			name:          "padding with spaces",
+			value:         "GET",
+			length:        7,
+			padChar:       " ",
+			expectedLeft:  "    GET",
+			expectedRight: "GET    ",
+		},
+		{
+			name:          "empty string",
+			value:         "",
+			length:        3,
+			padChar:       "-",
+			expectedLeft:  "---",
+			expectedRight: "---",
+		},
+		{
+			name:          "string of exactly length",
+			value:         "12345",
+			length:        5,
+			padChar:       "0",
+			expectedLeft:  "12345",
+			expectedRight: "12345",
+		},
+		{
+			name:          "longer string is unchanged",
+			value:         "1234567",
+			length:        5,
+			padChar:       "0",
+			expectedLeft:  "1234567",
+			expectedRight: "1234567",
+		},
+		{
+			name:          "length is counted in runes",
+			value:         "héllo",
+			length:        7,
+			padChar:       ".",
+			expectedLeft:  "..héllo",
+			expectedRight: "héllo..",
+		},
+		{
+			name:          "multibyte pad character",
+			value:         "7",
+			length:        3,
+			padChar:       "·",
+			expectedLeft:  "··7",
+			expectedRight: "7··",
+		},
+		{
+			name:          "zero length",
+			value:         "abc",
+			length:        0,
+			padChar:       " ",
+			expectedLeft:  "abc",
+			expectedRight: "abc",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getter := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+
+			exprFunc, err := PadLeft[interface{}](getter, tt.length, tt.padChar)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedLeft, result)
+
+			exprFunc, err = PadRight[interface{}](getter, tt.length, tt.padChar)
+			require.NoError(t, err)
+			result, err = exprFunc(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRight, result)
+		})
+	}
+}
+
+func Test_Pad_error(t *testing.T) {
+	getter := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(context.Context, interface{}) (interface{}, error) {
+			return int64(42), nil
+		},
+	}
+
+	exprFunc, err := PadLeft[interface{}](getter, 6, "0")
+	require.NoError(t, err)
+	_, err = exprFunc(nil, nil)
+	assert.Error(t, err)
+
+	exprFunc, err = PadRight[interface{}](getter, 6, "0")
+	require.NoError(t, err)
+	_, err = exprFunc(nil, nil)
+	assert.Error(t, err)
+}
+
+func Test_Pad_invalid_arguments(t *testing.T) {
+	tests := []struct {
+		name    string
+		length  int64
+		padChar string
+	}{
+		{
+			name:    "negative length",
+			length:  -1,
+			padChar: "0",
+		},
+		{
+			name:    "empty pad character",
+			length:  5,
+			padChar: "",
+		},
+		{
+			name:    "several pad characters",
+			length:  5,
+			padChar: "ab",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := PadLeft[interface{}](&ottl.StandardGetSetter[interface{}]{}, tt.length, tt.padChar)
+			assert.Error(t, err)
+			_, err = PadRight[interface{}](&ottl.StandardGetSetter[interface{}]{}, tt.length, tt.padChar)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// ParseFloatList factory function returns a `pcommon.Slice` of the float64 numbers of the target string separated by
+// the delimiter, e.g. [1.2, 3.4] for "1.2,3.4" and ",". Whitespace around the numbers is ignored and an empty string
+// is an empty list. An error is returned if an element is not a number.
+func ParseFloatList[K any](target ottl.Getter[K], delimiter string) (ottl.ExprFunc[K], error) {
+	if delimiter == "" {
+		return nil, errors.New("delimiter cannot be empty")
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		str, ok := val.(string)
+		if !ok {
+			return nil, fmt.Errorf("target must be a string but got %T", val)
+		}
+		result := pcommon.NewSlice()
+		if strings.TrimSpace(str) == "" {
+			return result, nil
+		}
+		elements := strings.Split(str, delimiter)
+		result.EnsureCapacity(len(elements))
+		for i, element := range elements {
+			f, err := strconv.ParseFloat(strings.TrimSpace(element), 64)
+			if err != nil {
+				return nil, fmt.Errorf("element %d %q is not a number", i, element)
+			}
+			result.AppendEmpty().SetDouble(f)
+		}
+		return result, nil
+	}, nil
+}
@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_ParseFloatList(t *testing.T) {
+	tests := []struct {
+		name      string
+		target    string
+		delimiter string
+		expected  []interface{}
+	}{
+		{
+			name:      "comma",
+			target:    "1.2,3.4,5.6",
+			delimiter: ",",
+			expected:  []interface{}{1.2, 3.4, 5.6},
+		},
+		{
+			name:      "semicolon with whitespace",
+			target:    " 1 ; -2.5 ;3e2 ",
+			delimiter: ";",
+			expected:  []interface{}{1.0, -2.5, 300.0},
+		},
+		{
+			name:      "multi-character delimiter",
+			target:    "0.1 | 0.2 | 0.3",
+			delimiter: " | ",
+			expected:  []interface{}{0.1, 0.2, 0.3},
+		},
+		{
+			name:      "single element",
+			target:    "42",
+			delimiter: ",",
+			expected:  []interface{}{42.0},
+		},
+		{
+			name:      "empty string",
+			target:    "",
+			delimiter: ",",
+			expected:  []interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ParseFloatList[interface{}](constGetter(tt.target), tt.delimiter)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			slice, ok := result.(pcommon.Slice)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, slice.AsRaw())
+		})
+	}
+}
+
+func Test_ParseFloatList_Error(t *testing.T) {
+	tests := []struct {
+		name      string
+		target    interface{}
+		delimiter string
+		expected  string
+	}{
+		{
+			name:      "malformed element",
+			target:    "1.2,abc,5.6",
+			delimiter: ",",
+			expected:  `element 1 "abc" is not a number`,
+		},
+		{
+			name:      "empty element",
+			target:    "1.2,,5.6",
+			delimiter: ",",
+			expected:  `element 1 "" is not a number`,
+		},
+		{
+			name:      "wrong delimiter",
+			target:    "1.2;3.4",
+			delimiter: ",",
+			expected:  `element 0 "1.2;3.4" is not a number`,
+		},
+		{
+			name:      "not a string",
+			target:    1.2,
+			delimiter: ",",
+			expected:  "target must be a string but got float64",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ParseFloatList[interface{}](constGetter(tt.target), tt.delimiter)
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
+
+func Test_ParseFloatList_EmptyDelimiter(t *testing.T) {
+	_, err := ParseFloatList[interface{}](constGetter("1,2"), "")
+	assert.EqualError(t, err, "delimiter cannot be empty")
+}
@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_ParseForm(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		expected map[string]interface{}
+	}{
+		{
+			name:   "single fields",
+			target: "user=alice&action=login",
+			expected: map[string]interface{}{
+				"user":   "alice",
+				"action": "login",
+			},
+		},
+		{
+			name:   "repeated keys",
+			target: "tag=a&id=1&tag=b&tag=c",
+			expected: map[string]interface{}{
+				"tag": []interface{}{"a", "b", "c"},
+				"id":  "1",
+			},
+		},
+		{
+			name:   "empty values",
+			target: "q=&flag&name=bob",
+			expected: map[string]interface{}{
+				"q":    "",
+				"flag": "",
+				"name": "bob",
+			},
+		},
+		{
+			name:   "plus and percent decoding",
+			target: "q=hello+world&path=%2Fapi%2Fv1%3Fx%3D1&caf%C3%A9=a%2Bb",
+			expected: map[string]interface{}{
+				"q":         "hello world",
+				"path":      "/api/v1?x=1",
+				"caf\u00e9": "a+b",
+			},
+		},
+		{
+			name:     "empty",
+			target:   "",
+			expected: map[string]interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ParseForm[interface{}](constGetter(tt.target))
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.(pcommon.Map).AsRaw())
+		})
+	}
+}
+
+func Test_ParseForm_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   interface{}
+		expected string
+	}{
+		{
+			name:     "malformed percent encoding",
+			target:   "q=100%&x=1",
+			expected: `invalid form: invalid URL escape "%"`,
+		},
+		{
+			name:     "semicolon separator",
+			target:   "a=1;b=2",
+			expected: "invalid form: invalid semicolon separator in query",
+		},
+		{
+			name:     "not a string",
+			target:   int64(1),
+			expected: "target must be a string but got int64",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ParseForm[interface{}](constGetter(tt.target))
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
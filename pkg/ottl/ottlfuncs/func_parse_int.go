@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func ParseInt[K any](target ottl.Getter[K], base int64) (ottl.ExprFunc[K], error) {
+	if base != 0 && (base < 2 || base > 36) {
+		return nil, fmt.Errorf("invalid base for ParseInt function, %d must be 0 or between 2 and 36", base)
+	}
+
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if valStr, ok := val.(string); ok {
+			intValue, err := strconv.ParseInt(valStr, int(base), 64)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse string %q as base %d integer: %w", valStr, base, err)
+			}
+			return intValue, nil
+		}
+		return nil, nil
+	}, nil
+}
@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ParseInt(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		base     int64
+		expected interface{}
+	}{
+		{
+			name:     "base 16",
+			value:    "1F",
+			base:     16,
+			expected: int64(31),
+		},
+		{
+			name:     "base 16 lowercase",
+			value:    "ff",
+			base:     16,
+			expected: int64(255),
+		},
+		{
+			name:     "base 2",
+			value:    "101",
+			base:     2,
+			expected: int64(5),
+		},
+		{
+			name:     "base 10 negative",
+			value:    "-42",
+			base:     10,
+			expected: int64(-42),
+		},
+		{
+			name:     "auto-detect hex prefix",
+			value:    "0x1F",
+			base:     0,
+			expected: int64(31),
+		},
+		{
+			name:     "auto-detect octal prefix",
+			value:    "0o17",
+			base:     0,
+			expected: int64(15),
+		},
+		{
+			name:     "auto-detect binary prefix",
+			value:    "0b101",
+			base:     0,
+			expected: int64(5),
+		},
+		{
+			name:     "auto-detect decimal",
+			value:    "123",
+			base:     0,
+			expected: int64(123),
+		},
+		{
+			name:     "max int64",
+			value:    "7fffffffffffffff",
+			base:     16,
+			expected: int64(math.MaxInt64),
+		},
+		{
+			name:     "int64",
+			value:    int64(1),
+			base:     10,
+			expected: nil,
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			base:     10,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ParseInt[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}, tt.base)
+			assert.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_ParseInt_error(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		base  int64
+	}{
+		{
+			name:  "empty string",
+			value: "",
+			base:  10,
+		},
+		{
+			name:  "invalid digit for base",
+			value: "102",
+			base:  2,
+		},
+		{
+			name:  "prefix with explicit base",
+			value: "0x1F",
+			base:  16,
+		},
+		{
+			name:  "overflowing string",
+			value: "8000000000000000",
+			base:  16,
+		},
+		{
+			name:  "underflowing string",
+			value: "-9223372036854775809",
+			base:  0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ParseInt[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}, tt.base)
+			assert.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func Test_ParseInt_invalid_base(t *testing.T) {
+	for _, base := range []int64{-1, 1, 37} {
+		_, err := ParseInt[interface{}](&ottl.StandardGetSetter[interface{}]{}, base)
+		assert.Error(t, err)
+	}
+}
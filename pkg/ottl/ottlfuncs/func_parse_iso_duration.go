@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// isoDurationRegexp matches the ISO 8601 duration format PnYnMnWnDTnHnMnS, in which only the seconds can have a
+// fraction.
+var isoDurationRegexp = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)(?:[.,](\d+))?S)?)?$`)
+
+// ParseISODuration factory function returns the number of nanoseconds of the target ISO 8601 duration as an int64,
+// e.g. 5400000000000 for "PT1H30M". A day is 24 hours and a week is 7 days. Years and months are not supported
+// because their length varies. Digits of the seconds beyond nanoseconds are truncated.
+func ParseISODuration[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		str, ok := val.(string)
+		if !ok {
+			return nil, fmt.Errorf("target must be a string but got %T", val)
+		}
+		return parseISODuration(str)
+	}, nil
+}
+
+func parseISODuration(s string) (int64, error) {
+	m := isoDurationRegexp.FindStringSubmatch(s)
+	// The regexp also matches a duration without any component, or with a time designator without a time component.
+	if m == nil || s == "P" || strings.HasSuffix(s, "T") {
+		return 0, fmt.Errorf("invalid ISO 8601 duration %q", s)
+	}
+	if m[1] != "" || m[2] != "" {
+		return 0, fmt.Errorf("ISO 8601 duration %q has years or months, which are not supported", s)
+	}
+	var total int64
+	for i, unit := range []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second} {
+		if m[i+3] == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(m[i+3], 10, 64)
+		if err != nil || n > (math.MaxInt64-total)/int64(unit) {
+			return 0, fmt.Errorf("ISO 8601 duration %q is too long: %w", s, errIntegerOverflow)
+		}
+		total += n * int64(unit)
+	}
+	if fraction := m[8]; fraction != "" {
+		if len(fraction) > 9 {
+			fraction = fraction[:9]
+		}
+		// The fraction is padded to nanoseconds, it has at most 9 digits so it cannot fail to parse.
+		nanos, _ := strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+		if nanos > math.MaxInt64-total {
+			return 0, fmt.Errorf("ISO 8601 duration %q is too long: %w", s, errIntegerOverflow)
+		}
+		total += nanos
+	}
+	return total, nil
+}
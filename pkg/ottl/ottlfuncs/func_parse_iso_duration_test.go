@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseISODuration(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		expected time.Duration
+	}{
+		{
+			name:     "hours and minutes",
+			target:   "PT1H30M",
+			expected: time.Hour + 30*time.Minute,
+		},
+		{
+			name:     "hours, minutes and seconds",
+			target:   "PT2H5M10S",
+			expected: 2*time.Hour + 5*time.Minute + 10*time.Second,
+		},
+		{
+			name:     "seconds only",
+			target:   "PT45S",
+			expected: 45 * time.Second,
+		},
+		{
+			name:     "minutes exceeding an hour",
+			target:   "PT90M",
+			expected: 90 * time.Minute,
+		},
+		{
+			name:     "fractional seconds",
+			target:   "PT1.5S",
+			expected: 1500 * time.Millisecond,
+		},
+		{
+			name:     "fractional seconds with comma",
+			target:   "PT0,000001S",
+			expected: time.Microsecond,
+		},
+		{
+			name:     "fraction beyond nanoseconds is truncated",
+			target:   "PT0.1234567899S",
+			expected: 123456789 * time.Nanosecond,
+		},
+		{
+			name:     "days and time",
+			target:   "P1DT12H",
+			expected: 36 * time.Hour,
+		},
+		{
+			name:     "weeks",
+			target:   "P2W",
+			expected: 14 * 24 * time.Hour,
+		},
+		{
+			name:     "zero",
+			target:   "PT0S",
+			expected: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ParseISODuration[interface{}](constGetter(tt.target))
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, int64(tt.expected), result)
+		})
+	}
+}
+
+func Test_ParseISODuration_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   interface{}
+		expected string
+	}{
+		{
+			name:     "years",
+			target:   "P1Y",
+			expected: `ISO 8601 duration "P1Y" has years or months, which are not supported`,
+		},
+		{
+			name:     "months",
+			target:   "P2M10D",
+			expected: `ISO 8601 duration "P2M10D" has years or months, which are not supported`,
+		},
+		{
+			name:     "no components",
+			target:   "P",
+			expected: `invalid ISO 8601 duration "P"`,
+		},
+		{
+			name:     "time designator without time components",
+			target:   "P1DT",
+			expected: `invalid ISO 8601 duration "P1DT"`,
+		},
+		{
+			name:     "time components without time designator",
+			target:   "P1H",
+			expected: `invalid ISO 8601 duration "P1H"`,
+		},
+		{
+			name:     "fractional minutes",
+			target:   "PT1.5M",
+			expected: `invalid ISO 8601 duration "PT1.5M"`,
+		},
+		{
+			name:     "Go duration",
+			target:   "1h30m",
+			expected: `invalid ISO 8601 duration "1h30m"`,
+		},
+		{
+			name:     "too long",
+			target:   "PT9999999999999H",
+			expected: `ISO 8601 duration "PT9999999999999H" is too long: integer overflow`,
+		},
+		{
+			name:     "not a string",
+			target:   int64(60),
+			expected: "target must be a string but got int64",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ParseISODuration[interface{}](constGetter(tt.target))
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
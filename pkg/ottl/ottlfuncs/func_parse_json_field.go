@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+
+	jsoniter "github.com/json-iterator/go"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// ParseJSONField factory function returns a copy of the target `pcommon.Map` in which the string value of key is
+// replaced by the map that results from parsing it as a JSON object, using the same conversions as ParseJSON.
+// If the key is missing or its value is not a string, the copy is returned unchanged if ignoreMissing is true, and
+// an error is returned otherwise. A value that is not a valid JSON object is always an error.
+func ParseJSONField[K any](target ottl.Getter[K], key string, ignoreMissing bool) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		targetVal, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		m, ok := targetVal.(pcommon.Map)
+		if !ok {
+			return nil, fmt.Errorf("target must be a map but got %T", targetVal)
+		}
+		result := pcommon.NewMap()
+		m.CopyTo(result)
+		val, exists := result.Get(key)
+		if !exists {
+			if ignoreMissing {
+				return result, nil
+			}
+			return nil, fmt.Errorf("key %q not found in target", key)
+		}
+		if val.Type() != pcommon.ValueTypeStr {
+			if ignoreMissing {
+				return result, nil
+			}
+			return nil, fmt.Errorf("value of key %q must be a string but got %v", key, val.Type())
+		}
+		var parsedValue map[string]interface{}
+		if err = jsoniter.UnmarshalFromString(val.Str(), &parsedValue); err != nil {
+			return nil, fmt.Errorf("value of key %q is not a valid JSON object: %w", key, err)
+		}
+		if err = result.PutEmptyMap(key).FromRaw(parsedValue); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}, nil
+}
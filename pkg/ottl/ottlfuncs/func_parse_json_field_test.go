@@ -0,0 +1,179 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_ParseJSONField(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        map[string]interface{}
+		key           string
+		ignoreMissing bool
+		expected      map[string]interface{}
+	}{
+		{
+			name: "JSON in JSON",
+			target: map[string]interface{}{
+				"level":   "info",
+				"message": `{"user":{"id":1,"name":"alice"},"tags":["a","b"]}`,
+			},
+			key: "message",
+			expected: map[string]interface{}{
+				"level": "info",
+				"message": map[string]interface{}{
+					"user": map[string]interface{}{
+						"id":   1.0,
+						"name": "alice",
+					},
+					"tags": []interface{}{"a", "b"},
+				},
+			},
+		},
+		{
+			name: "nested JSON string is not expanded",
+			target: map[string]interface{}{
+				"outer": `{"inner":"{\"a\":true}"}`,
+			},
+			key: "outer",
+			expected: map[string]interface{}{
+				"outer": map[string]interface{}{
+					"inner": `{"a":true}`,
+				},
+			},
+		},
+		{
+			name: "ignore missing key",
+			target: map[string]interface{}{
+				"level": "info",
+			},
+			key:           "message",
+			ignoreMissing: true,
+			expected: map[string]interface{}{
+				"level": "info",
+			},
+		},
+		{
+			name: "ignore non-string value",
+			target: map[string]interface{}{
+				"message": int64(1),
+			},
+			key:           "message",
+			ignoreMissing: true,
+			expected: map[string]interface{}{
+				"message": int64(1),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := pcommon.NewMap()
+			require.NoError(t, target.FromRaw(tt.target))
+			original := target.AsRaw()
+			exprFunc, err := ParseJSONField[interface{}](constGetter(target), tt.key, tt.ignoreMissing)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			resultMap, ok := result.(pcommon.Map)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, resultMap.AsRaw())
+			assert.Equal(t, original, target.AsRaw())
+		})
+	}
+}
+
+func Test_ParseJSONField_Repeated(t *testing.T) {
+	target := pcommon.NewMap()
+	require.NoError(t, target.FromRaw(map[string]interface{}{
+		"outer": `{"inner":"{\"a\":true}"}`,
+	}))
+	exprFunc, err := ParseJSONField[interface{}](constGetter(target), "outer", false)
+	require.NoError(t, err)
+	result, err := exprFunc(context.Background(), nil)
+	require.NoError(t, err)
+	outer, ok := result.(pcommon.Map).Get("outer")
+	require.True(t, ok)
+
+	exprFunc, err = ParseJSONField[interface{}](constGetter(outer.Map()), "inner", false)
+	require.NoError(t, err)
+	result, err = exprFunc(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"inner": map[string]interface{}{
+			"a": true,
+		},
+	}, result.(pcommon.Map).AsRaw())
+}
+
+func Test_ParseJSONField_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   interface{}
+		key      string
+		expected string
+	}{
+		{
+			name:     "target is not a map",
+			target:   `{"a":1}`,
+			key:      "a",
+			expected: "target must be a map but got string",
+		},
+		{
+			name:     "missing key",
+			target:   map[string]interface{}{"level": "info"},
+			key:      "message",
+			expected: `key "message" not found in target`,
+		},
+		{
+			name:     "non-string value",
+			target:   map[string]interface{}{"message": true},
+			key:      "message",
+			expected: `value of key "message" must be a string but got Bool`,
+		},
+		{
+			name:     "invalid JSON",
+			target:   map[string]interface{}{"message": "not json"},
+			key:      "message",
+			expected: `value of key "message" is not a valid JSON object`,
+		},
+		{
+			name:     "JSON array",
+			target:   map[string]interface{}{"message": "[1,2]"},
+			key:      "message",
+			expected: `value of key "message" is not a valid JSON object`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := tt.target
+			if raw, ok := target.(map[string]interface{}); ok {
+				m := pcommon.NewMap()
+				require.NoError(t, m.FromRaw(raw))
+				target = m
+			}
+			exprFunc, err := ParseJSONField[interface{}](constGetter(target), tt.key, false)
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.ErrorContains(t, err, tt.expected)
+		})
+	}
+}
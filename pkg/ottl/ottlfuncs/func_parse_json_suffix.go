@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	jsoniter "github.com/json-iterator/go"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// ParseJSONSuffix factory function returns a `pcommon.Map` that is the result of parsing the JSON object found
+// at the end of the target string, ignoring any leading text. Each `{` in the target is tried in order until the
+// remainder of the string parses as a JSON object. Values are converted the same way as in ParseJSON.
+// The leading text, with surrounding whitespace trimmed, is stored under prefixKey unless it is empty or
+// prefixKey is empty. It replaces any value the JSON object has under the same key.
+func ParseJSONSuffix[K any](target ottl.Getter[K], prefixKey string) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		targetVal, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		str, ok := targetVal.(string)
+		if !ok {
+			return nil, fmt.Errorf("target must be a string but got %T", targetVal)
+		}
+		for start := strings.IndexByte(str, '{'); start >= 0; {
+			var parsedValue map[string]interface{}
+			if jsoniter.UnmarshalFromString(str[start:], &parsedValue) == nil {
+				result := pcommon.NewMap()
+				if err = result.FromRaw(parsedValue); err != nil {
+					return nil, err
+				}
+				if prefixKey != "" {
+					putNonEmptyStr(result, prefixKey, strings.TrimSpace(str[:start]))
+				}
+				return result, nil
+			}
+			next := strings.IndexByte(str[start+1:], '{')
+			if next < 0 {
+				break
+			}
+			start += next + 1
+		}
+		return nil, errors.New("target does not end with a JSON object")
+	}, nil
+}
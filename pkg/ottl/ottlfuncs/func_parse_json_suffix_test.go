@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ParseJSONSuffix(t *testing.T) {
+	tests := []struct {
+		name      string
+		target    string
+		prefixKey string
+		expected  map[string]interface{}
+	}{
+		{
+			name:      "prefixed JSON",
+			target:    `2023-01-02T15:04:05Z INFO request done {"status":200,"path":"/health"}`,
+			prefixKey: "prefix",
+			expected: map[string]interface{}{
+				"status": float64(200),
+				"path":   "/health",
+				"prefix": "2023-01-02T15:04:05Z INFO request done",
+			},
+		},
+		{
+			name:      "no prefix",
+			target:    `{"level":"info","nested":{"ok":true}}`,
+			prefixKey: "prefix",
+			expected: map[string]interface{}{
+				"level":  "info",
+				"nested": map[string]interface{}{"ok": true},
+			},
+		},
+		{
+			name:      "prefix contains a brace",
+			target:    `worker {3} finished: {"items":[1,2]}`,
+			prefixKey: "prefix",
+			expected: map[string]interface{}{
+				"items":  []interface{}{float64(1), float64(2)},
+				"prefix": "worker {3} finished:",
+			},
+		},
+		{
+			name:      "prefix replaces JSON key",
+			target:    `text {"prefix":"json"}`,
+			prefixKey: "prefix",
+			expected: map[string]interface{}{
+				"prefix": "text",
+			},
+		},
+		{
+			name:      "prefix dropped",
+			target:    `text {"a":"b"}`,
+			prefixKey: "",
+			expected: map[string]interface{}{
+				"a": "b",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.target, nil
+				},
+			}
+			exprFunc, err := ParseJSONSuffix[interface{}](target, tt.prefixKey)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			resultMap, ok := result.(pcommon.Map)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, resultMap.AsRaw())
+		})
+	}
+}
+
+func Test_ParseJSONSuffix_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   interface{}
+		expected string
+	}{
+		{
+			name:     "no JSON",
+			target:   "plain text line",
+			expected: "target does not end with a JSON object",
+		},
+		{
+			name:     "invalid JSON",
+			target:   `text {"a":`,
+			expected: "target does not end with a JSON object",
+		},
+		{
+			name:     "trailing text",
+			target:   `text {"a":"b"} more text`,
+			expected: "target does not end with a JSON object",
+		},
+		{
+			name:     "not a string",
+			target:   int64(1),
+			expected: "target must be a string but got int64",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.target, nil
+				},
+			}
+			exprFunc, err := ParseJSONSuffix[interface{}](target, "prefix")
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+
+	jsoniter "github.com/json-iterator/go"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// ParseJSONWithMaxDepth factory function behaves like ParseJSON, but returns an error instead of a result
+// when objects and arrays in the target string are nested more than maxDepth levels deep.
+// The top-level object is at depth 1.
+func ParseJSONWithMaxDepth[K any](target ottl.Getter[K], maxDepth int64) (ottl.ExprFunc[K], error) {
+	if maxDepth < 1 {
+		return nil, fmt.Errorf("maxDepth must be positive but got %d", maxDepth)
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		targetVal, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		jsonStr, ok := targetVal.(string)
+		if !ok {
+			return nil, fmt.Errorf("target must be a string but got %T", targetVal)
+		}
+		var parsedValue map[string]interface{}
+		err = jsoniter.UnmarshalFromString(jsonStr, &parsedValue)
+		if err != nil {
+			return nil, err
+		}
+		result := pcommon.NewMap()
+		err = putJSONMap(result, parsedValue, 1, maxDepth)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	}, nil
+}
+
+// putJSONMap copies raw into dest, which is at the given depth, failing once maxDepth is exceeded.
+func putJSONMap(dest pcommon.Map, raw map[string]interface{}, depth, maxDepth int64) error {
+	if depth > maxDepth {
+		return fmt.Errorf("JSON exceeds the maximum depth of %d", maxDepth)
+	}
+	dest.EnsureCapacity(len(raw))
+	for k, v := range raw {
+		if err := putJSONValue(dest.PutEmpty(k), v, depth, maxDepth); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// putJSONValue sets dest, a member of a container at the given depth, to the JSON value v.
+func putJSONValue(dest pcommon.Value, v interface{}, depth, maxDepth int64) error {
+	switch tv := v.(type) {
+	case map[string]interface{}:
+		return putJSONMap(dest.SetEmptyMap(), tv, depth+1, maxDepth)
+	case []interface{}:
+		if depth+1 > maxDepth {
+			return fmt.Errorf("JSON exceeds the maximum depth of %d", maxDepth)
+		}
+		slice := dest.SetEmptySlice()
+		slice.EnsureCapacity(len(tv))
+		for _, elem := range tv {
+			if err := putJSONValue(slice.AppendEmpty(), elem, depth+1, maxDepth); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return dest.FromRaw(v)
+	}
+}
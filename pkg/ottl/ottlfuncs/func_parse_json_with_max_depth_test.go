@@ -0,0 +1,150 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func nestedJSON(depth int) string {
+	return strings.Repeat(`{"a":`, depth) + "1" + strings.Repeat("}", depth)
+}
+
+func Test_ParseJSONWithMaxDepth(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		maxDepth int64
+		expected map[string]interface{}
+	}{
+		{
+			name:     "flat object",
+			target:   `{"test":"string value","test2":2,"test3":true,"test4":null}`,
+			maxDepth: 1,
+			expected: map[string]interface{}{
+				"test":  "string value",
+				"test2": float64(2),
+				"test3": true,
+				"test4": nil,
+			},
+		},
+		{
+			name:     "nested at max depth",
+			target:   `{"a":{"b":[1,{"c":"d"}]}}`,
+			maxDepth: 4,
+			expected: map[string]interface{}{
+				"a": map[string]interface{}{
+					"b": []interface{}{float64(1), map[string]interface{}{"c": "d"}},
+				},
+			},
+		},
+		{
+			name:     "empty containers",
+			target:   `{"a":{},"b":[]}`,
+			maxDepth: 2,
+			expected: map[string]interface{}{
+				"a": map[string]interface{}{},
+				"b": []interface{}{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.target, nil
+				},
+			}
+			exprFunc, err := ParseJSONWithMaxDepth[interface{}](target, tt.maxDepth)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			resultMap, ok := result.(pcommon.Map)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, resultMap.AsRaw())
+		})
+	}
+}
+
+func Test_ParseJSONWithMaxDepth_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		maxDepth int64
+		expected string
+	}{
+		{
+			name:     "object too deep",
+			target:   `{"a":{"b":{"c":1}}}`,
+			maxDepth: 2,
+			expected: "JSON exceeds the maximum depth of 2",
+		},
+		{
+			name:     "array too deep",
+			target:   `{"a":[[1]]}`,
+			maxDepth: 2,
+			expected: "JSON exceeds the maximum depth of 2",
+		},
+		{
+			name:     "thousands of levels",
+			target:   nestedJSON(5000),
+			maxDepth: 100,
+			expected: "JSON exceeds the maximum depth of 100",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.target, nil
+				},
+			}
+			exprFunc, err := ParseJSONWithMaxDepth[interface{}](target, tt.maxDepth)
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
+
+func Test_ParseJSONWithMaxDepth_BeyondDecoderLimit(t *testing.T) {
+	target := ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+			return nestedJSON(100000), nil
+		},
+	}
+	exprFunc, err := ParseJSONWithMaxDepth[interface{}](target, 1000000)
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.ErrorContains(t, err, "exceeded max depth")
+}
+
+func Test_ParseJSONWithMaxDepth_InvalidMaxDepth(t *testing.T) {
+	target := ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+			return "{}", nil
+		},
+	}
+	_, err := ParseJSONWithMaxDepth[interface{}](target, 0)
+	assert.EqualError(t, err, "maxDepth must be positive but got 0")
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// numberLocale is the convention for writing the digit groups and the decimal part of a number.
+type numberLocale struct {
+	groupSeparator   string
+	decimalSeparator string
+	// pattern matches a number with an optional sign, digit groups of three digits and decimal part
+	pattern *regexp.Regexp
+}
+
+func newNumberLocale(groupSeparator string, decimalSeparator string) numberLocale {
+	g, d := regexp.QuoteMeta(groupSeparator), regexp.QuoteMeta(decimalSeparator)
+	return numberLocale{
+		groupSeparator:   groupSeparator,
+		decimalSeparator: decimalSeparator,
+		pattern:          regexp.MustCompile(`^[+-]?(\d+|\d{1,3}(` + g + `\d{3})+)(` + d + `\d+)?$`),
+	}
+}
+
+var numberLocales = map[string]numberLocale{
+	"us": newNumberLocale(",", "."),
+	"eu": newNumberLocale(".", ","),
+}
+
+// ParseNumber factory function returns the target string parsed as a float64 number written with the digit group
+// and decimal separators of the locale, e.g. "1,234.56" in the "us" locale or "1.234,56" in the "eu" locale.
+func ParseNumber[K any](target ottl.Getter[K], locale string) (ottl.ExprFunc[K], error) {
+	l, ok := numberLocales[locale]
+	if !ok {
+		return nil, fmt.Errorf("invalid locale %q, allowed locales are: us, eu", locale)
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		str, ok := val.(string)
+		if !ok {
+			return nil, fmt.Errorf("target must be a string but got %T", val)
+		}
+		str = strings.TrimSpace(str)
+		if !l.pattern.MatchString(str) {
+			return nil, fmt.Errorf("%q is not a number in the %q locale", str, locale)
+		}
+		str = strings.ReplaceAll(str, l.groupSeparator, "")
+		return strconv.ParseFloat(strings.Replace(str, l.decimalSeparator, ".", 1), 64)
+	}, nil
+}
@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseNumber(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		locale   string
+		expected float64
+	}{
+		{
+			name:     "us grouped with decimals",
+			target:   "1,234.56",
+			locale:   "us",
+			expected: 1234.56,
+		},
+		{
+			name:     "us several groups",
+			target:   "-12,345,678",
+			locale:   "us",
+			expected: -12345678,
+		},
+		{
+			name:     "us without groups",
+			target:   "1234.5",
+			locale:   "us",
+			expected: 1234.5,
+		},
+		{
+			name:     "eu grouped with decimals",
+			target:   "1.234,56",
+			locale:   "eu",
+			expected: 1234.56,
+		},
+		{
+			name:     "eu several groups",
+			target:   "+12.345.678,9",
+			locale:   "eu",
+			expected: 12345678.9,
+		},
+		{
+			name:     "eu decimals only",
+			target:   " 0,25 ",
+			locale:   "eu",
+			expected: 0.25,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ParseNumber[interface{}](constGetter(tt.target), tt.locale)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_ParseNumber_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   interface{}
+		locale   string
+		expected string
+	}{
+		{
+			name:     "us decimal separator in eu locale",
+			target:   "1,234.56",
+			locale:   "eu",
+			expected: `"1,234.56" is not a number in the "eu" locale`,
+		},
+		{
+			name:     "misplaced group separator",
+			target:   "12,34.5",
+			locale:   "us",
+			expected: `"12,34.5" is not a number in the "us" locale`,
+		},
+		{
+			name:     "several decimal separators",
+			target:   "1.2.3",
+			locale:   "us",
+			expected: `"1.2.3" is not a number in the "us" locale`,
+		},
+		{
+			name:     "not a number",
+			target:   "abc",
+			locale:   "us",
+			expected: `"abc" is not a number in the "us" locale`,
+		},
+		{
+			name:     "empty",
+			target:   "",
+			locale:   "us",
+			expected: `"" is not a number in the "us" locale`,
+		},
+		{
+			name:     "not a string",
+			target:   1234.56,
+			locale:   "us",
+			expected: "target must be a string but got float64",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ParseNumber[interface{}](constGetter(tt.target), tt.locale)
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
+
+func Test_ParseNumber_InvalidLocale(t *testing.T) {
+	_, err := ParseNumber[interface{}](constGetter("1,234.56"), "fr")
+	assert.EqualError(t, err, `invalid locale "fr", allowed locales are: us, eu`)
+}
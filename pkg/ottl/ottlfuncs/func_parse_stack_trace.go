@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+const (
+	stackTraceLanguageGo   = "go"
+	stackTraceLanguageJava = "java"
+)
+
+var (
+	// goFileLinePattern matches the file and line of a Go frame, e.g. "\t/app/main.go:12 +0x1d"
+	goFileLinePattern = regexp.MustCompile(`^\t(.+):(\d+)(?: \+0x[0-9a-f]+)?$`)
+	// goCreatedByPattern matches the function that started a goroutine, e.g. "created by main.run in goroutine 1"
+	goCreatedByPattern = regexp.MustCompile(`^created by (\S+?)(?: in goroutine \d+)?$`)
+	// javaFramePattern matches a Java frame with an optional module prefix, e.g. "\tat java.base/java.lang.Thread.run(Thread.java:829)"
+	javaFramePattern = regexp.MustCompile(`^\s*at\s+(?:\S*/)?([^\s/(]+)\((.*)\)\s*$`)
+)
+
+// ParseStackTrace factory function returns the frames of the target stack trace as a slice of maps with the
+// function, file and line of each frame. The language of the stack trace is either "go" or "java". The frames of
+// Java exceptions are followed by the frames of the exceptions that caused them. The file and line are left out of
+// the frames where they are unknown, e.g. native Java methods.
+func ParseStackTrace[K any](target ottl.Getter[K], language string) (ottl.ExprFunc[K], error) {
+	var parse func(stackTrace string, frames pcommon.Slice)
+	switch language {
+	case stackTraceLanguageGo:
+		parse = parseGoStackTrace
+	case stackTraceLanguageJava:
+		parse = parseJavaStackTrace
+	default:
+		return nil, fmt.Errorf("invalid language %q, must be either %q or %q", language, stackTraceLanguageGo, stackTraceLanguageJava)
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		stackTrace, ok := val.(string)
+		if !ok {
+			return nil, fmt.Errorf("target must be a string but got %T", val)
+		}
+		frames := pcommon.NewSlice()
+		parse(stackTrace, frames)
+		return frames, nil
+	}, nil
+}
+
+// parseGoStackTrace appends the frames of a Go stack trace, where each frame is a function call followed by a line
+// with its file and line, e.g. "main.main()\n\t/app/main.go:12 +0x1d".
+func parseGoStackTrace(stackTrace string, frames pcommon.Slice) {
+	lines := strings.Split(strings.ReplaceAll(stackTrace, "\r\n", "\n"), "\n")
+	for i := 1; i < len(lines); i++ {
+		match := goFileLinePattern.FindStringSubmatch(lines[i])
+		if match == nil {
+			continue
+		}
+		function := goFunctionName(lines[i-1])
+		if function == "" {
+			continue
+		}
+		line, err := strconv.ParseInt(match[2], 10, 64)
+		if err != nil {
+			continue
+		}
+		frame := frames.AppendEmpty().SetEmptyMap()
+		frame.PutStr("function", function)
+		frame.PutStr("file", match[1])
+		frame.PutInt("line", line)
+	}
+}
+
+// goFunctionName returns the name of the function called in a line of a Go stack trace without its arguments,
+// or an empty string if the line is not a function call.
+func goFunctionName(line string) string {
+	if match := goCreatedByPattern.FindStringSubmatch(line); match != nil {
+		return match[1]
+	}
+	if strings.HasPrefix(line, "\t") || strings.HasPrefix(line, "goroutine ") || !strings.HasSuffix(line, ")") {
+		return ""
+	}
+	if i := strings.LastIndex(line, "("); i > 0 {
+		return line[:i]
+	}
+	return ""
+}
+
+// parseJavaStackTrace appends the frames of a Java stack trace, e.g. "\tat com.example.App.main(App.java:12)".
+// Lines that are not frames, such as exception messages, "Caused by:" lines and "... 5 more", are skipped.
+func parseJavaStackTrace(stackTrace string, frames pcommon.Slice) {
+	for _, l := range strings.Split(stackTrace, "\n") {
+		match := javaFramePattern.FindStringSubmatch(l)
+		if match == nil {
+			continue
+		}
+		frame := frames.AppendEmpty().SetEmptyMap()
+		frame.PutStr("function", match[1])
+		location := match[2]
+		if location == "Native Method" || location == "Unknown Source" || location == "" {
+			continue
+		}
+		if i := strings.LastIndex(location, ":"); i > 0 {
+			if line, err := strconv.ParseInt(location[i+1:], 10, 64); err == nil {
+				frame.PutStr("file", location[:i])
+				frame.PutInt("line", line)
+				continue
+			}
+		}
+		frame.PutStr("file", location)
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+const testGoStackTrace = `panic: runtime error: invalid memory address or nil pointer dereference
+[signal SIGSEGV: segmentation violation code=0x1 addr=0x0 pc=0x48f0b6]
+
+goroutine 7 [running]:
+example.com/app/server.(*Server).handle(0xc0000a4000, {0x5d7a20, 0xc0000b2000})
+	/src/app/server/server.go:42 +0x56
+example.com/app/server.Map[...](...)
+	/src/app/server/generic.go:10
+created by example.com/app/server.(*Server).Serve in goroutine 1
+	/src/app/server/server.go:27 +0x8f
+
+goroutine 1 [chan receive]:
+main.main()
+	/src/app/main.go:12 +0x1d
+`
+
+const testJavaStackTrace = `java.lang.IllegalStateException: request failed
+	at com.example.app.Handler.handle(Handler.java:87)
+	at java.base/java.lang.Thread.run(Thread.java:829)
+Caused by: java.io.IOException: connection reset
+	at com.example.app.Client.read(Client.java)
+	at sun.nio.ch.SocketDispatcher.read0(Native Method)
+	at com.example.app.Client$Reader.lambda$read$0(Unknown Source)
+	... 2 more
+Caused by: java.net.SocketException: broken pipe
+	at com.example.app.Socket.write(Socket.java:12)
+	... 4 more
+`
+
+func Test_ParseStackTrace(t *testing.T) {
+	tests := []struct {
+		name       string
+		language   string
+		stackTrace string
+		expected   []interface{}
+	}{
+		{
+			name:       "go",
+			language:   "go",
+			stackTrace: testGoStackTrace,
+			expected: []interface{}{
+				map[string]interface{}{"function": "example.com/app/server.(*Server).handle", "file": "/src/app/server/server.go", "line": int64(42)},
+				map[string]interface{}{"function": "example.com/app/server.Map[...]", "file": "/src/app/server/generic.go", "line": int64(10)},
+				map[string]interface{}{"function": "example.com/app/server.(*Server).Serve", "file": "/src/app/server/server.go", "line": int64(27)},
+				map[string]interface{}{"function": "main.main", "file": "/src/app/main.go", "line": int64(12)},
+			},
+		},
+		{
+			name:       "go with CRLF line endings",
+			language:   "go",
+			stackTrace: "goroutine 1 [running]:\r\nmain.main()\r\n\t/src/app/main.go:12 +0x1d\r\n",
+			expected: []interface{}{
+				map[string]interface{}{"function": "main.main", "file": "/src/app/main.go", "line": int64(12)},
+			},
+		},
+		{
+			name:       "java with caused by chain",
+			language:   "java",
+			stackTrace: testJavaStackTrace,
+			expected: []interface{}{
+				map[string]interface{}{"function": "com.example.app.Handler.handle", "file": "Handler.java", "line": int64(87)},
+				map[string]interface{}{"function": "java.lang.Thread.run", "file": "Thread.java", "line": int64(829)},
+				map[string]interface{}{"function": "com.example.app.Client.read", "file": "Client.java"},
+				map[string]interface{}{"function": "sun.nio.ch.SocketDispatcher.read0"},
+				map[string]interface{}{"function": "com.example.app.Client$Reader.lambda$read$0"},
+				map[string]interface{}{"function": "com.example.app.Socket.write", "file": "Socket.java", "line": int64(12)},
+			},
+		},
+		{
+			name:       "no frames",
+			language:   "java",
+			stackTrace: "java.lang.IllegalStateException: request failed",
+			expected:   []interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.stackTrace, nil
+				},
+			}
+			exprFunc, err := ParseStackTrace[interface{}](target, tt.language)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			require.NoError(t, err)
+			require.IsType(t, pcommon.Slice{}, result)
+			assert.Equal(t, tt.expected, result.(pcommon.Slice).AsRaw())
+		})
+	}
+}
+
+func Test_ParseStackTrace_Error(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(context.Context, interface{}) (interface{}, error) {
+			return int64(1), nil
+		},
+	}
+	exprFunc, err := ParseStackTrace[interface{}](target, "go")
+	require.NoError(t, err)
+	_, err = exprFunc(nil, nil)
+	assert.EqualError(t, err, "target must be a string but got int64")
+
+	_, err = ParseStackTrace[interface{}](target, "python")
+	assert.EqualError(t, err, `invalid language "python", must be either "go" or "java"`)
+}
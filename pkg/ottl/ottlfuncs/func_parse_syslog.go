@@ -0,0 +1,269 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+const (
+	syslogProtocolRFC5424 = "rfc5424"
+	syslogProtocolRFC3164 = "rfc3164"
+
+	// syslogNilValue is the value of the RFC5424 header fields and structured data that are not set
+	syslogNilValue = "-"
+	// syslogMaxTagLength is the maximum length of the RFC3164 tag
+	syslogMaxTagLength = 32
+)
+
+// ParseSyslog factory function returns a map of the fields of the target syslog line, which is parsed according to
+// the protocol, either "rfc5424" or "rfc3164". Only the fields present in the line are added to the map:
+//
+//	priority        -> the priority as an int64
+//	facility        -> the facility derived from the priority as an int64
+//	severity        -> the severity derived from the priority as an int64
+//	timestamp       -> the timestamp as it appears in the line
+//	hostname        -> the hostname
+//	appname         -> the application name, or the tag for RFC3164
+//	procid          -> the process ID
+//	msgid           -> the message ID, RFC5424 only
+//	structured_data -> a map of the structured data elements keyed by their ID with a map of their parameters, RFC5424 only
+//	message         -> the message
+//
+// An error is returned if the target is not a string or is not a valid syslog line.
+func ParseSyslog[K any](target ottl.Getter[K], protocol string) (ottl.ExprFunc[K], error) {
+	var parse func(line string, result pcommon.Map) error
+	switch protocol {
+	case syslogProtocolRFC5424:
+		parse = parseRFC5424
+	case syslogProtocolRFC3164:
+		parse = parseRFC3164
+	default:
+		return nil, fmt.Errorf("invalid protocol %q, must be either %q or %q", protocol, syslogProtocolRFC5424, syslogProtocolRFC3164)
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		line, ok := val.(string)
+		if !ok {
+			return nil, fmt.Errorf("target must be a string but got %T", val)
+		}
+		result := pcommon.NewMap()
+		if err := parse(line, result); err != nil {
+			return nil, fmt.Errorf("invalid %s syslog line: %w", protocol, err)
+		}
+		return result, nil
+	}, nil
+}
+
+// parseRFC5424 parses a syslog line of the form
+// "<PRI>VERSION TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA [MSG]".
+func parseRFC5424(line string, result pcommon.Map) error {
+	rest, err := parseSyslogPriority(line, result)
+	if err != nil {
+		return err
+	}
+	version, rest, _ := strings.Cut(rest, " ")
+	if _, err := strconv.ParseUint(version, 10, 8); err != nil || version == "0" || len(version) > 2 {
+		return fmt.Errorf("invalid version %q", version)
+	}
+
+	headerFields := []string{"timestamp", "hostname", "appname", "procid", "msgid", "structured data"}
+	for i, name := range headerFields[:len(headerFields)-1] {
+		var value string
+		var ok bool
+		value, rest, ok = strings.Cut(rest, " ")
+		if value == "" {
+			return fmt.Errorf("missing %s", name)
+		}
+		if !ok {
+			return fmt.Errorf("missing %s", headerFields[i+1])
+		}
+		if value == syslogNilValue {
+			continue
+		}
+		if name == "timestamp" {
+			if _, err := time.Parse(time.RFC3339Nano, value); err != nil {
+				return fmt.Errorf("invalid timestamp %q", value)
+			}
+		}
+		result.PutStr(name, value)
+	}
+
+	if strings.HasPrefix(rest, syslogNilValue) {
+		rest = rest[len(syslogNilValue):]
+	} else {
+		rest, err = parseSyslogStructuredData(rest, result.PutEmptyMap("structured_data"))
+		if err != nil {
+			return err
+		}
+	}
+
+	if rest == "" {
+		return nil
+	}
+	if rest[0] != ' ' {
+		return errors.New("missing space before message")
+	}
+	putNonEmptyStr(result, "message", strings.TrimPrefix(rest[1:], "\ufeff"))
+	return nil
+}
+
+// parseSyslogStructuredData parses the RFC5424 structured data elements at the start of the string into the map
+// and returns the rest of the string, e.g. `[exampleSDID@32473 iut="3" eventSource="Application"]`.
+func parseSyslogStructuredData(s string, structuredData pcommon.Map) (string, error) {
+	if !strings.HasPrefix(s, "[") {
+		return "", errors.New("missing structured data")
+	}
+	for strings.HasPrefix(s, "[") {
+		end := strings.IndexAny(s, " ]")
+		if end < 0 {
+			return "", errors.New("unterminated structured data element")
+		}
+		id := s[1:end]
+		if id == "" || strings.ContainsAny(id, `="`) {
+			return "", fmt.Errorf("invalid structured data ID %q", id)
+		}
+		params := structuredData.PutEmptyMap(id)
+		s = s[end:]
+		for s[0] == ' ' {
+			name, value, ok := strings.Cut(s[1:], `="`)
+			if !ok || name == "" || strings.ContainsAny(name, ` ]"`) {
+				return "", fmt.Errorf("invalid parameter in structured data element %q", id)
+			}
+			value, s, ok = parseSyslogParamValue(value)
+			if !ok {
+				return "", fmt.Errorf("unterminated parameter value in structured data element %q", id)
+			}
+			if s == "" {
+				return "", errors.New("unterminated structured data element")
+			}
+			params.PutStr(name, value)
+		}
+		if s[0] != ']' {
+			return "", fmt.Errorf("invalid structured data element %q", id)
+		}
+		s = s[1:]
+	}
+	return s, nil
+}
+
+// parseSyslogParamValue returns the unescaped structured data parameter value at the start of the string up to the
+// closing quote and the rest of the string after it. It returns false if the value is not terminated.
+func parseSyslogParamValue(s string) (string, string, bool) {
+	var value strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			return value.String(), s[i+1:], true
+		case '\\':
+			// Only '"', '\' and ']' are escaped, other backslashes are kept as is
+			if i+1 < len(s) && strings.IndexByte(`"\]`, s[i+1]) >= 0 {
+				i++
+			}
+		}
+		value.WriteByte(s[i])
+	}
+	return "", "", false
+}
+
+// parseRFC3164 parses a syslog line of the form "<PRI>Mmm dd hh:mm:ss HOSTNAME TAG[PID]: MSG". The line is only
+// split into a tag, a process ID and a message if its content starts with a tag.
+func parseRFC3164(line string, result pcommon.Map) error {
+	rest, err := parseSyslogPriority(line, result)
+	if err != nil {
+		return err
+	}
+	if len(rest) < len(time.Stamp) {
+		return errors.New("missing timestamp")
+	}
+	timestamp := rest[:len(time.Stamp)]
+	if _, err := time.Parse(time.Stamp, timestamp); err != nil {
+		return fmt.Errorf("invalid timestamp %q", timestamp)
+	}
+	result.PutStr("timestamp", timestamp)
+	rest = rest[len(time.Stamp):]
+	if rest == "" {
+		return nil
+	}
+	if rest[0] != ' ' {
+		return errors.New("missing space after timestamp")
+	}
+
+	hostname, content, _ := strings.Cut(rest[1:], " ")
+	if hostname == "" {
+		return errors.New("missing hostname")
+	}
+	result.PutStr("hostname", hostname)
+
+	if tag, procid, message, ok := parseSyslogTag(content); ok {
+		result.PutStr("appname", tag)
+		putNonEmptyStr(result, "procid", procid)
+		putNonEmptyStr(result, "message", message)
+		return nil
+	}
+	putNonEmptyStr(result, "message", content)
+	return nil
+}
+
+// parseSyslogTag splits the RFC3164 content of the form "TAG[PID]: MSG" or "TAG: MSG" into its parts. It returns
+// false if the content does not start with a tag.
+func parseSyslogTag(content string) (tag string, procid string, message string, ok bool) {
+	end := strings.IndexAny(content, " :[")
+	if end <= 0 || end > syslogMaxTagLength {
+		return "", "", "", false
+	}
+	tag, rest := content[:end], content[end:]
+	if rest[0] == '[' {
+		var found bool
+		procid, rest, found = strings.Cut(rest[1:], "]")
+		if !found || procid == "" {
+			return "", "", "", false
+		}
+	}
+	if !strings.HasPrefix(rest, ":") {
+		return "", "", "", false
+	}
+	return tag, procid, strings.TrimPrefix(rest[1:], " "), true
+}
+
+// parseSyslogPriority parses the "<PRI>" at the start of the line into the priority, facility and severity of
+// the map and returns the rest of the line.
+func parseSyslogPriority(line string, result pcommon.Map) (string, error) {
+	end := strings.IndexByte(line, '>')
+	if !strings.HasPrefix(line, "<") || end < 2 || end > 4 {
+		return "", errors.New("missing priority")
+	}
+	digits := line[1:end]
+	priority, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil || digits[0] < '0' || digits[0] > '9' || priority > 191 || (len(digits) > 1 && digits[0] == '0') {
+		return "", fmt.Errorf("invalid priority %q", digits)
+	}
+	result.PutInt("priority", priority)
+	result.PutInt("facility", priority/8)
+	result.PutInt("severity", priority%8)
+	return line[end+1:], nil
+}
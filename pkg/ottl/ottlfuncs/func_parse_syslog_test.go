@@ -0,0 +1,259 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ParseSyslog(t *testing.T) {
+	tests := []struct {
+		name     string
+		protocol string
+		line     string
+		expected map[string]interface{}
+	}{
+		{
+			name:     "RFC5424",
+			protocol: "rfc5424",
+			line:     "<34>1 2003-10-11T22:14:15.003Z mymachine.example.com su - ID47 - 'su root' failed for lonvick on /dev/pts/8",
+			expected: map[string]interface{}{
+				"priority":  int64(34),
+				"facility":  int64(4),
+				"severity":  int64(2),
+				"timestamp": "2003-10-11T22:14:15.003Z",
+				"hostname":  "mymachine.example.com",
+				"appname":   "su",
+				"msgid":     "ID47",
+				"message":   "'su root' failed for lonvick on /dev/pts/8",
+			},
+		},
+		{
+			name:     "RFC5424 with structured data",
+			protocol: "rfc5424",
+			line:     `<165>1 2003-10-11T22:14:15.003-07:00 mymachine.example.com evntslog 1234 ID47 [exampleSDID@32473 iut="3" eventSource="Application" eventID="1011"][examplePriority@32473 class="high"] An application event log entry...`,
+			expected: map[string]interface{}{
+				"priority":  int64(165),
+				"facility":  int64(20),
+				"severity":  int64(5),
+				"timestamp": "2003-10-11T22:14:15.003-07:00",
+				"hostname":  "mymachine.example.com",
+				"appname":   "evntslog",
+				"procid":    "1234",
+				"msgid":     "ID47",
+				"structured_data": map[string]interface{}{
+					"exampleSDID@32473": map[string]interface{}{
+						"iut":         "3",
+						"eventSource": "Application",
+						"eventID":     "1011",
+					},
+					"examplePriority@32473": map[string]interface{}{
+						"class": "high",
+					},
+				},
+				"message": "An application event log entry...",
+			},
+		},
+		{
+			name:     "RFC5424 with escaped structured data and no message",
+			protocol: "rfc5424",
+			line:     `<13>1 - - - - - [meta sequenceId="1" path="C:\\logs" note="say \"hi\" [ok\]"]`,
+			expected: map[string]interface{}{
+				"priority": int64(13),
+				"facility": int64(1),
+				"severity": int64(5),
+				"structured_data": map[string]interface{}{
+					"meta": map[string]interface{}{
+						"sequenceId": "1",
+						"path":       `C:\logs`,
+						"note":       `say "hi" [ok]`,
+					},
+				},
+			},
+		},
+		{
+			name:     "RFC5424 with BOM",
+			protocol: "rfc5424",
+			line:     "<0>1 2023-01-02T15:04:05Z host app 42 - - \ufeffhello",
+			expected: map[string]interface{}{
+				"priority":  int64(0),
+				"facility":  int64(0),
+				"severity":  int64(0),
+				"timestamp": "2023-01-02T15:04:05Z",
+				"hostname":  "host",
+				"appname":   "app",
+				"procid":    "42",
+				"message":   "hello",
+			},
+		},
+		{
+			name:     "RFC3164",
+			protocol: "rfc3164",
+			line:     "<34>Oct 11 22:14:15 mymachine su: 'su root' failed for lonvick on /dev/pts/8",
+			expected: map[string]interface{}{
+				"priority":  int64(34),
+				"facility":  int64(4),
+				"severity":  int64(2),
+				"timestamp": "Oct 11 22:14:15",
+				"hostname":  "mymachine",
+				"appname":   "su",
+				"message":   "'su root' failed for lonvick on /dev/pts/8",
+			},
+		},
+		{
+			name:     "RFC3164 with process ID",
+			protocol: "rfc3164",
+			line:     "<13>Feb  5 17:32:18 10.0.0.99 sshd[4123]: Accepted publickey for root",
+			expected: map[string]interface{}{
+				"priority":  int64(13),
+				"facility":  int64(1),
+				"severity":  int64(5),
+				"timestamp": "Feb  5 17:32:18",
+				"hostname":  "10.0.0.99",
+				"appname":   "sshd",
+				"procid":    "4123",
+				"message":   "Accepted publickey for root",
+			},
+		},
+		{
+			name:     "RFC3164 without tag",
+			protocol: "rfc3164",
+			line:     "<191>Feb  5 17:32:18 host use the BFG!",
+			expected: map[string]interface{}{
+				"priority":  int64(191),
+				"facility":  int64(23),
+				"severity":  int64(7),
+				"timestamp": "Feb  5 17:32:18",
+				"hostname":  "host",
+				"message":   "use the BFG!",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.line, nil
+				},
+			}
+			exprFunc, err := ParseSyslog[interface{}](target, tt.protocol)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			require.NoError(t, err)
+			require.IsType(t, pcommon.Map{}, result)
+			assert.Equal(t, tt.expected, result.(pcommon.Map).AsRaw())
+		})
+	}
+}
+
+func Test_ParseSyslog_Error(t *testing.T) {
+	tests := []struct {
+		name        string
+		protocol    string
+		value       interface{}
+		expectedErr string
+	}{
+		{
+			name:        "non-string target",
+			protocol:    "rfc5424",
+			value:       int64(1),
+			expectedErr: "target must be a string but got int64",
+		},
+		{
+			name:        "missing priority",
+			protocol:    "rfc5424",
+			value:       "1 2003-10-11T22:14:15.003Z host app - - -",
+			expectedErr: "invalid rfc5424 syslog line: missing priority",
+		},
+		{
+			name:        "priority out of range",
+			protocol:    "rfc3164",
+			value:       "<192>Oct 11 22:14:15 host app: message",
+			expectedErr: `invalid rfc3164 syslog line: invalid priority "192"`,
+		},
+		{
+			name:        "invalid version",
+			protocol:    "rfc5424",
+			value:       "<34>0 2003-10-11T22:14:15.003Z host app - - -",
+			expectedErr: `invalid rfc5424 syslog line: invalid version "0"`,
+		},
+		{
+			name:        "invalid timestamp",
+			protocol:    "rfc5424",
+			value:       "<34>1 2003-10-11 host app - - -",
+			expectedErr: `invalid rfc5424 syslog line: invalid timestamp "2003-10-11"`,
+		},
+		{
+			name:        "missing header fields",
+			protocol:    "rfc5424",
+			value:       "<34>1 2003-10-11T22:14:15.003Z host app",
+			expectedErr: "invalid rfc5424 syslog line: missing procid",
+		},
+		{
+			name:        "missing structured data",
+			protocol:    "rfc5424",
+			value:       "<34>1 2003-10-11T22:14:15.003Z host app - - message",
+			expectedErr: "invalid rfc5424 syslog line: missing structured data",
+		},
+		{
+			name:        "unterminated structured data",
+			protocol:    "rfc5424",
+			value:       `<34>1 - - - - - [meta key="value"`,
+			expectedErr: "invalid rfc5424 syslog line: unterminated structured data element",
+		},
+		{
+			name:        "unterminated parameter value",
+			protocol:    "rfc5424",
+			value:       `<34>1 - - - - - [meta key="value]`,
+			expectedErr: `invalid rfc5424 syslog line: unterminated parameter value in structured data element "meta"`,
+		},
+		{
+			name:        "invalid RFC3164 timestamp",
+			protocol:    "rfc3164",
+			value:       "<34>2003-10-11T22:14:15Z host app: message",
+			expectedErr: `invalid rfc3164 syslog line: invalid timestamp "2003-10-11T22:1"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := ParseSyslog[interface{}](target, tt.protocol)
+			require.NoError(t, err)
+			_, err = exprFunc(nil, nil)
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func Test_ParseSyslog_InvalidProtocol(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(context.Context, interface{}) (interface{}, error) {
+			return "", nil
+		},
+	}
+	_, err := ParseSyslog[interface{}](target, "rfc5425")
+	assert.EqualError(t, err, `invalid protocol "rfc5425", must be either "rfc5424" or "rfc3164"`)
+}
@@ -0,0 +1,146 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ParseTime(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		value    string
+		layout   string
+		location string
+		expected time.Time
+	}{
+		{
+			name:     "RFC3339",
+			value:    "2023-01-02T15:04:05Z",
+			layout:   time.RFC3339,
+			location: "",
+			expected: time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		{
+			name:     "RFC3339 with offset",
+			value:    "2023-01-02T15:04:05.123+02:00",
+			layout:   time.RFC3339Nano,
+			location: "",
+			expected: time.Date(2023, 1, 2, 13, 4, 5, 123000000, time.UTC),
+		},
+		{
+			name:     "custom layout",
+			value:    "02/Jan/2023:15:04:05",
+			layout:   "02/Jan/2006:15:04:05",
+			location: "",
+			expected: time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		{
+			name:     "location",
+			value:    "2023-07-01 12:00:00",
+			layout:   "2006-01-02 15:04:05",
+			location: "America/New_York",
+			expected: time.Date(2023, 7, 1, 12, 0, 0, 0, newYork),
+		},
+		{
+			name:     "offset in the value wins over the location",
+			value:    "2023-07-01 12:00:00 +0000",
+			layout:   "2006-01-02 15:04:05 -0700",
+			location: "America/New_York",
+			expected: time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ParseTime[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}, tt.layout, tt.location)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result.(time.Time)), "expected %v but got %v", tt.expected, result)
+		})
+	}
+}
+
+func Test_ParseTime_location(t *testing.T) {
+	exprFunc, err := ParseTime[interface{}](&ottl.StandardGetSetter[interface{}]{
+		Getter: func(context.Context, interface{}) (interface{}, error) {
+			return "2023-07-01 12:00:00", nil
+		},
+	}, "2006-01-02 15:04:05", "America/New_York")
+	require.NoError(t, err)
+	result, err := exprFunc(nil, nil)
+	require.NoError(t, err)
+	// New York is on daylight saving time in July
+	assert.Equal(t, int64(1688227200), result.(time.Time).Unix())
+	assert.Equal(t, "America/New_York", result.(time.Time).Location().String())
+}
+
+func Test_ParseTime_error(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  interface{}
+		layout string
+	}{
+		{
+			name:   "value does not match the layout",
+			value:  "2023-01-02",
+			layout: time.RFC3339,
+		},
+		{
+			name:   "garbage",
+			value:  "not a time",
+			layout: "2006-01-02",
+		},
+		{
+			name:   "not a string",
+			value:  int64(1672671845),
+			layout: time.RFC3339,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ParseTime[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}, tt.layout, "")
+			require.NoError(t, err)
+			_, err = exprFunc(nil, nil)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func Test_ParseTime_invalid_arguments(t *testing.T) {
+	_, err := ParseTime[interface{}](&ottl.StandardGetSetter[interface{}]{}, "", "")
+	assert.Error(t, err)
+
+	_, err = ParseTime[interface{}](&ottl.StandardGetSetter[interface{}]{}, time.RFC3339, "Mars/Olympus_Mons")
+	assert.Error(t, err)
+}
@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// defaultURLPorts are the ports of the schemes used when a URL has no port.
+var defaultURLPorts = map[string]int64{
+	"http":  80,
+	"https": 443,
+	"ws":    80,
+	"wss":   443,
+	"ftp":   21,
+}
+
+// ParseURL factory function returns a map of the components of the target URL, in the form accepted by BuildURL.
+// Only the components present in the URL are added to the map:
+//
+//	scheme   -> the URL scheme
+//	user     -> the user name, the password is never added
+//	host     -> the host name or IP address without a port or brackets
+//	port     -> the port as an int, the default port of the scheme if the URL has none
+//	path     -> the decoded URL path
+//	query    -> a map of the decoded query parameters, the values of repeated parameters are a slice
+//	fragment -> the decoded fragment
+func ParseURL[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		urlStr, ok := val.(string)
+		if !ok {
+			return nil, fmt.Errorf("target must be a string but got %T", val)
+		}
+		u, err := url.Parse(urlStr)
+		if err != nil {
+			return nil, err
+		}
+		query, err := url.ParseQuery(u.RawQuery)
+		if err != nil {
+			return nil, fmt.Errorf("invalid URL query: %w", err)
+		}
+
+		components := pcommon.NewMap()
+		putNonEmptyStr(components, "scheme", u.Scheme)
+		if u.User != nil {
+			putNonEmptyStr(components, "user", u.User.Username())
+		}
+		putNonEmptyStr(components, "host", u.Hostname())
+		if portStr := u.Port(); portStr != "" {
+			port, err := strconv.ParseInt(portStr, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid URL port %q: %w", portStr, err)
+			}
+			components.PutInt("port", port)
+		} else if port, ok := defaultURLPorts[u.Scheme]; ok && u.Host != "" {
+			components.PutInt("port", port)
+		}
+		putNonEmptyStr(components, "path", u.Path)
+		if len(query) > 0 {
+			putURLValues(components.PutEmptyMap("query"), query)
+		}
+		putNonEmptyStr(components, "fragment", u.Fragment)
+		return components, nil
+	}, nil
+}
+
+// putURLValues puts the values of each key in m sorted by key. The values of a key repeated several times are a slice.
+func putURLValues(m pcommon.Map, values url.Values) {
+	for k, vs := range values {
+		if len(vs) == 1 {
+			m.PutStr(k, vs[0])
+			continue
+		}
+		slice := m.PutEmptySlice(k)
+		for _, v := range vs {
+			slice.AppendEmpty().SetStr(v)
+		}
+	}
+	m.Sort()
+}
+
+func putNonEmptyStr(m pcommon.Map, key string, value string) {
+	if value != "" {
+		m.PutStr(key, value)
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+const (
+	userAgentOther   = "Other"
+	userAgentSpider  = "Spider"
+	userAgentDesktop = "Desktop"
+)
+
+// userAgentRule matches a user agent. The version is the first submatch of the pattern, if any.
+type userAgentRule struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+// userAgentClientRules are checked in order, as most browsers also claim to be the browsers they are based on,
+// e.g. Edge user agents contain "Chrome/" and "Safari/".
+var userAgentClientRules = []userAgentRule{
+	{name: "Googlebot", pattern: regexp.MustCompile(`Googlebot(?:-\w+)?/(\d+(?:\.\d+)*)`)},
+	{name: "Bingbot", pattern: regexp.MustCompile(`bingbot/(\d+(?:\.\d+)*)`)},
+	{name: "YandexBot", pattern: regexp.MustCompile(`YandexBot/(\d+(?:\.\d+)*)`)},
+	{name: "DuckDuckBot", pattern: regexp.MustCompile(`DuckDuckBot(?:-\w+)?/(\d+(?:\.\d+)*)`)},
+	{name: "Baiduspider", pattern: regexp.MustCompile(`Baiduspider(?:-\w+)?/(\d+(?:\.\d+)*)`)},
+	{name: "Applebot", pattern: regexp.MustCompile(`Applebot/(\d+(?:\.\d+)*)`)},
+	{name: "curl", pattern: regexp.MustCompile(`^curl/(\d+(?:\.\d+)*)`)},
+	{name: "Wget", pattern: regexp.MustCompile(`^Wget/(\d+(?:\.\d+)*)`)},
+	{name: "Python Requests", pattern: regexp.MustCompile(`^python-requests/(\d+(?:\.\d+)*)`)},
+	{name: "Go-http-client", pattern: regexp.MustCompile(`^Go-http-client/(\d+(?:\.\d+)*)`)},
+	{name: "okhttp", pattern: regexp.MustCompile(`^okhttp/(\d+(?:\.\d+)*)`)},
+	{name: "Edge", pattern: regexp.MustCompile(`Edg(?:e|A|iOS)?/(\d+(?:\.\d+)*)`)},
+	{name: "Opera", pattern: regexp.MustCompile(`(?:OPR|Opera)/(\d+(?:\.\d+)*)`)},
+	{name: "Samsung Internet", pattern: regexp.MustCompile(`SamsungBrowser/(\d+(?:\.\d+)*)`)},
+	{name: "Firefox", pattern: regexp.MustCompile(`(?:Firefox|FxiOS)/(\d+(?:\.\d+)*)`)},
+	{name: "Chrome", pattern: regexp.MustCompile(`(?:Chrome|CriOS)/(\d+(?:\.\d+)*)`)},
+	{name: "Safari", pattern: regexp.MustCompile(`Version/(\d+(?:\.\d+)*).*Safari/`)},
+	{name: "Internet Explorer", pattern: regexp.MustCompile(`(?:MSIE |Trident/.*rv:)(\d+(?:\.\d+)*)`)},
+}
+
+// userAgentOSRules are checked in order, e.g. Android user agents contain "Linux".
+var userAgentOSRules = []userAgentRule{
+	{name: "Windows", pattern: regexp.MustCompile(`Windows NT (\d+\.\d+)`)},
+	{name: "iOS", pattern: regexp.MustCompile(`(?:iPhone|CPU) OS (\d+(?:_\d+)*)`)},
+	{name: "Android", pattern: regexp.MustCompile(`Android (\d+(?:\.\d+)*)`)},
+	{name: "Mac OS X", pattern: regexp.MustCompile(`Mac OS X (\d+(?:[_.]\d+)*)`)},
+	{name: "Chrome OS", pattern: regexp.MustCompile(`CrOS \w+ (\d+(?:\.\d+)*)`)},
+	{name: "Linux", pattern: regexp.MustCompile(`Linux`)},
+}
+
+// windowsVersions maps the Windows NT versions to the Windows versions.
+var windowsVersions = map[string]string{
+	"10.0": "10",
+	"6.3":  "8.1",
+	"6.2":  "8",
+	"6.1":  "7",
+	"6.0":  "Vista",
+	"5.1":  "XP",
+}
+
+var spiderPattern = regexp.MustCompile(`(?i)bot\b|crawler|spider|slurp`)
+
+// ParseUserAgent factory function returns a map of the client, operating system and device of the target user agent:
+//
+//	name       -> the name of the client, e.g. "Chrome", or "Other" if it is unknown
+//	version    -> the version of the client, if known
+//	os.name    -> the name of the operating system, e.g. "Windows", or "Other" if it is unknown
+//	os.version -> the version of the operating system, if known
+//	device     -> "Spider" for bots, "iPhone", "iPad", "Mobile" or "Tablet" for mobile devices, "Desktop" for desktop
+//	              operating systems and "Other" otherwise
+//
+// The user agent is parsed on a best-effort basis with a built-in set of rules covering the common browsers,
+// operating systems, command line clients and bots. Unknown user agents never result in an error.
+func ParseUserAgent[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		userAgent, ok := val.(string)
+		if !ok {
+			return nil, fmt.Errorf("target must be a string but got %T", val)
+		}
+
+		result := pcommon.NewMap()
+		name, version := matchUserAgentRules(userAgent, userAgentClientRules)
+		result.PutStr("name", name)
+		putNonEmptyStr(result, "version", version)
+
+		osName, osVersion := matchUserAgentRules(userAgent, userAgentOSRules)
+		switch osName {
+		case "Windows":
+			if v, ok := windowsVersions[osVersion]; ok {
+				osVersion = v
+			}
+		case "iOS", "Mac OS X":
+			osVersion = strings.ReplaceAll(osVersion, "_", ".")
+		}
+		result.PutStr("os.name", osName)
+		putNonEmptyStr(result, "os.version", osVersion)
+
+		result.PutStr("device", userAgentDevice(userAgent, osName))
+		return result, nil
+	}, nil
+}
+
+// matchUserAgentRules returns the name and version of the first matching rule, or "Other" if no rule matches.
+func matchUserAgentRules(userAgent string, rules []userAgentRule) (string, string) {
+	for _, rule := range rules {
+		match := rule.pattern.FindStringSubmatch(userAgent)
+		if match == nil {
+			continue
+		}
+		if len(match) > 1 {
+			return rule.name, match[1]
+		}
+		return rule.name, ""
+	}
+	return userAgentOther, ""
+}
+
+func userAgentDevice(userAgent string, osName string) string {
+	switch {
+	case spiderPattern.MatchString(userAgent):
+		return userAgentSpider
+	case strings.Contains(userAgent, "iPad"):
+		return "iPad"
+	case strings.Contains(userAgent, "iPhone"):
+		return "iPhone"
+	case osName == "Android" && strings.Contains(userAgent, "Mobile"):
+		return "Mobile"
+	case osName == "Android":
+		return "Tablet"
+	case osName == "Windows" || osName == "Mac OS X" || osName == "Chrome OS" || osName == "Linux":
+		return userAgentDesktop
+	default:
+		return userAgentOther
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ParseUserAgent(t *testing.T) {
+	tests := []struct {
+		name      string
+		userAgent string
+		expected  map[string]interface{}
+	}{
+		{
+			name:      "Chrome on Windows",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			expected: map[string]interface{}{
+				"name":       "Chrome",
+				"version":    "120.0.0.0",
+				"os.name":    "Windows",
+				"os.version": "10",
+				"device":     "Desktop",
+			},
+		},
+		{
+			name:      "Edge on Windows",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.2210.91",
+			expected: map[string]interface{}{
+				"name":       "Edge",
+				"version":    "120.0.2210.91",
+				"os.name":    "Windows",
+				"os.version": "10",
+				"device":     "Desktop",
+			},
+		},
+		{
+			name:      "Firefox on Linux",
+			userAgent: "Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0",
+			expected: map[string]interface{}{
+				"name":    "Firefox",
+				"version": "121.0",
+				"os.name": "Linux",
+				"device":  "Desktop",
+			},
+		},
+		{
+			name:      "Safari on macOS",
+			userAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Safari/605.1.15",
+			expected: map[string]interface{}{
+				"name":       "Safari",
+				"version":    "17.1",
+				"os.name":    "Mac OS X",
+				"os.version": "10.15.7",
+				"device":     "Desktop",
+			},
+		},
+		{
+			name:      "Internet Explorer 11",
+			userAgent: "Mozilla/5.0 (Windows NT 6.1; WOW64; Trident/7.0; rv:11.0) like Gecko",
+			expected: map[string]interface{}{
+				"name":       "Internet Explorer",
+				"version":    "11.0",
+				"os.name":    "Windows",
+				"os.version": "7",
+				"device":     "Desktop",
+			},
+		},
+		{
+			name:      "Safari on iPhone",
+			userAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_1_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1.2 Mobile/15E148 Safari/604.1",
+			expected: map[string]interface{}{
+				"name":       "Safari",
+				"version":    "17.1.2",
+				"os.name":    "iOS",
+				"os.version": "17.1.2",
+				"device":     "iPhone",
+			},
+		},
+		{
+			name:      "Chrome on iPad",
+			userAgent: "Mozilla/5.0 (iPad; CPU OS 16_6 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) CriOS/119.0.6045.169 Mobile/15E148 Safari/604.1",
+			expected: map[string]interface{}{
+				"name":       "Chrome",
+				"version":    "119.0.6045.169",
+				"os.name":    "iOS",
+				"os.version": "16.6",
+				"device":     "iPad",
+			},
+		},
+		{
+			name:      "Chrome on Android phone",
+			userAgent: "Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.43 Mobile Safari/537.36",
+			expected: map[string]interface{}{
+				"name":       "Chrome",
+				"version":    "120.0.6099.43",
+				"os.name":    "Android",
+				"os.version": "14",
+				"device":     "Mobile",
+			},
+		},
+		{
+			name:      "Samsung Internet on Android tablet",
+			userAgent: "Mozilla/5.0 (Linux; Android 13; SM-X700) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/23.0 Chrome/115.0.0.0 Safari/537.36",
+			expected: map[string]interface{}{
+				"name":       "Samsung Internet",
+				"version":    "23.0",
+				"os.name":    "Android",
+				"os.version": "13",
+				"device":     "Tablet",
+			},
+		},
+		{
+			name:      "Googlebot",
+			userAgent: "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+			expected: map[string]interface{}{
+				"name":    "Googlebot",
+				"version": "2.1",
+				"os.name": "Other",
+				"device":  "Spider",
+			},
+		},
+		{
+			name:      "Googlebot smartphone",
+			userAgent: "Mozilla/5.0 (Linux; Android 6.0.1; Nexus 5X Build/MMB29P) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.71 Mobile Safari/537.36 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+			expected: map[string]interface{}{
+				"name":       "Googlebot",
+				"version":    "2.1",
+				"os.name":    "Android",
+				"os.version": "6.0.1",
+				"device":     "Spider",
+			},
+		},
+		{
+			name:      "unknown bot",
+			userAgent: "Mozilla/5.0 (compatible; ExampleCrawler/1.0; +https://example.com/crawler)",
+			expected: map[string]interface{}{
+				"name":    "Other",
+				"os.name": "Other",
+				"device":  "Spider",
+			},
+		},
+		{
+			name:      "curl",
+			userAgent: "curl/8.4.0",
+			expected: map[string]interface{}{
+				"name":    "curl",
+				"version": "8.4.0",
+				"os.name": "Other",
+				"device":  "Other",
+			},
+		},
+		{
+			name:      "unknown",
+			userAgent: "my-custom-agent",
+			expected: map[string]interface{}{
+				"name":    "Other",
+				"os.name": "Other",
+				"device":  "Other",
+			},
+		},
+		{
+			name:      "empty",
+			userAgent: "",
+			expected: map[string]interface{}{
+				"name":    "Other",
+				"os.name": "Other",
+				"device":  "Other",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ParseUserAgent[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.userAgent, nil
+				},
+			})
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.(pcommon.Map).AsRaw())
+		})
+	}
+}
+
+func Test_ParseUserAgent_error(t *testing.T) {
+	exprFunc, err := ParseUserAgent[interface{}](&ottl.StandardGetSetter[interface{}]{
+		Getter: func(context.Context, interface{}) (interface{}, error) {
+			return int64(1), nil
+		},
+	})
+	require.NoError(t, err)
+	_, err = exprFunc(nil, nil)
+	assert.Error(t, err)
+}
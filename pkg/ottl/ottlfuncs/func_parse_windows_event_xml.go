@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// windowsEventXML is the part of the rendered XML of a Windows event extracted by ParseWindowsEventXML.
+type windowsEventXML struct {
+	XMLName  xml.Name `xml:"Event"`
+	EventID  string   `xml:"System>EventID"`
+	Level    string   `xml:"System>Level"`
+	Channel  string   `xml:"System>Channel"`
+	Computer string   `xml:"System>Computer"`
+	Provider struct {
+		Name string `xml:"Name,attr"`
+	} `xml:"System>Provider"`
+	EventData []struct {
+		Name  string `xml:"Name,attr"`
+		Value string `xml:",chardata"`
+	} `xml:"EventData>Data"`
+}
+
+// ParseWindowsEventXML factory function returns a flat map of the fields of the target rendered Windows event XML:
+//
+//	EventID  -> the event ID as an int
+//	Level    -> the level as an int, e.g. 4 for information
+//	Channel  -> the channel, e.g. "Security"
+//	Computer -> the name of the computer that logged the event
+//	Provider -> the name of the provider that raised the event
+//
+// Each named "Data" element of the "EventData" is added with its name as key and its value as string. The values of
+// unnamed "Data" elements are added as a slice with the "Data" key. The fields of the System element take precedence
+// over "Data" elements with the same name. Fields that are missing or empty in the event are not added.
+func ParseWindowsEventXML[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		str, ok := val.(string)
+		if !ok {
+			return nil, fmt.Errorf("target must be a string but got %T", val)
+		}
+		var event windowsEventXML
+		if err = xml.Unmarshal([]byte(str), &event); err != nil {
+			return nil, fmt.Errorf("invalid Windows event XML: %w", err)
+		}
+
+		fields := pcommon.NewMap()
+		var unnamed []string
+		for _, data := range event.EventData {
+			if data.Name == "" {
+				unnamed = append(unnamed, data.Value)
+				continue
+			}
+			fields.PutStr(data.Name, data.Value)
+		}
+		if len(unnamed) > 0 {
+			slice := fields.PutEmptySlice("Data")
+			for _, value := range unnamed {
+				slice.AppendEmpty().SetStr(value)
+			}
+		}
+		if err = putWindowsEventInt(fields, "EventID", event.EventID); err != nil {
+			return nil, err
+		}
+		if err = putWindowsEventInt(fields, "Level", event.Level); err != nil {
+			return nil, err
+		}
+		putNonEmptyStr(fields, "Channel", strings.TrimSpace(event.Channel))
+		putNonEmptyStr(fields, "Computer", strings.TrimSpace(event.Computer))
+		putNonEmptyStr(fields, "Provider", event.Provider.Name)
+		return fields, nil
+	}, nil
+}
+
+// putWindowsEventInt puts the value of the field of a Windows event as an int if it is not empty.
+func putWindowsEventInt(m pcommon.Map, key string, value string) error {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	i, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid Windows event %s %q", key, value)
+	}
+	m.PutInt(key, i)
+	return nil
+}
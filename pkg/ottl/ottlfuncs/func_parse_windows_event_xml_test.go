@@ -0,0 +1,161 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+const testWindowsEventXML = `<Event xmlns='http://schemas.microsoft.com/win/2004/08/events/event'>
+  <System>
+    <Provider Name='Microsoft-Windows-Security-Auditing' Guid='{54849625-5478-4994-a5ba-3e3b0328c30d}'/>
+    <EventID>4624</EventID>
+    <Version>2</Version>
+    <Level>0</Level>
+    <Task>12544</Task>
+    <Opcode>0</Opcode>
+    <Keywords>0x8020000000000000</Keywords>
+    <TimeCreated SystemTime='2022-12-19T14:58:59.1287406Z'/>
+    <EventRecordID>201418</EventRecordID>
+    <Correlation ActivityID='{d3a5a2f4-13a0-0000-52a3-a5d3a013d901}'/>
+    <Execution ProcessID='752' ThreadID='8928'/>
+    <Channel>Security</Channel>
+    <Computer>DESKTOP-0S2A2B1</Computer>
+    <Security/>
+  </System>
+  <EventData>
+    <Data Name='SubjectUserSid'>S-1-5-18</Data>
+    <Data Name='SubjectUserName'>DESKTOP-0S2A2B1$</Data>
+    <Data Name='TargetUserName'>SYSTEM</Data>
+    <Data Name='LogonType'>5</Data>
+    <Data Name='IpAddress'>-</Data>
+    <Data Name='WorkstationName'></Data>
+  </EventData>
+  <RenderingInfo Culture='en-US'>
+    <Message>An account was successfully logged on.</Message>
+    <Level>Information</Level>
+  </RenderingInfo>
+</Event>`
+
+func Test_ParseWindowsEventXML(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		expected map[string]interface{}
+	}{
+		{
+			name:   "rendered event",
+			target: testWindowsEventXML,
+			expected: map[string]interface{}{
+				"EventID":         int64(4624),
+				"Level":           int64(0),
+				"Channel":         "Security",
+				"Computer":        "DESKTOP-0S2A2B1",
+				"Provider":        "Microsoft-Windows-Security-Auditing",
+				"SubjectUserSid":  "S-1-5-18",
+				"SubjectUserName": "DESKTOP-0S2A2B1$",
+				"TargetUserName":  "SYSTEM",
+				"LogonType":       "5",
+				"IpAddress":       "-",
+				"WorkstationName": "",
+			},
+		},
+		{
+			name: "unnamed event data",
+			target: `<Event><System><Provider Name="Application Error"/><EventID Qualifiers="0">1000</EventID>` +
+				`<Level>2</Level><Channel>Application</Channel><Computer>host</Computer></System>` +
+				`<EventData><Data>app.exe</Data><Data>1.0.0.0</Data></EventData></Event>`,
+			expected: map[string]interface{}{
+				"EventID":  int64(1000),
+				"Level":    int64(2),
+				"Channel":  "Application",
+				"Computer": "host",
+				"Provider": "Application Error",
+				"Data":     []interface{}{"app.exe", "1.0.0.0"},
+			},
+		},
+		{
+			name: "system fields take precedence",
+			target: `<Event><System><EventID>7036</EventID><Channel>System</Channel></System>` +
+				`<EventData><Data Name="Channel">other</Data><Data Name="param1">Windows Update</Data></EventData></Event>`,
+			expected: map[string]interface{}{
+				"EventID": int64(7036),
+				"Channel": "System",
+				"param1":  "Windows Update",
+			},
+		},
+		{
+			name:     "missing fields",
+			target:   `<Event><System></System></Event>`,
+			expected: map[string]interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ParseWindowsEventXML[interface{}](constGetter(tt.target))
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.(pcommon.Map).AsRaw())
+		})
+	}
+}
+
+func Test_ParseWindowsEventXML_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   interface{}
+		expected string
+	}{
+		{
+			name:     "malformed XML",
+			target:   `<Event><System><EventID>4624</System></Event>`,
+			expected: "invalid Windows event XML: XML syntax error on line 1: element <EventID> closed by </System>",
+		},
+		{
+			name:     "not an event",
+			target:   `<Log><EventID>4624</EventID></Log>`,
+			expected: "invalid Windows event XML: expected element type <Event> but have <Log>",
+		},
+		{
+			name:     "empty",
+			target:   "",
+			expected: "invalid Windows event XML: EOF",
+		},
+		{
+			name:     "invalid event ID",
+			target:   `<Event><System><EventID>abc</EventID></System></Event>`,
+			expected: `invalid Windows event EventID "abc"`,
+		},
+		{
+			name:     "not a string",
+			target:   int64(1),
+			expected: "target must be a string but got int64",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ParseWindowsEventXML[interface{}](constGetter(tt.target))
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
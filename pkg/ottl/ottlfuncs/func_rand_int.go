@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// RandInt factory function returns a uniformly distributed random int64 in the half-open interval [min, max).
+// The result is not deterministic: each call returns a new value from a source seeded with the current time.
+func RandInt[K any](min int64, max int64) (ottl.ExprFunc[K], error) {
+	if min >= max {
+		return nil, fmt.Errorf("min must be less than max but got min %d and max %d", min, max)
+	}
+	// The width of the interval may not fit in an int64, but always fits in a uint64.
+	width := uint64(max) - uint64(min)
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	var mu sync.Mutex
+	return func(context.Context, K) (interface{}, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if width <= math.MaxInt64 {
+			return min + r.Int63n(int64(width)), nil
+		}
+		// Rejection sampling keeps the distribution uniform, and accepts more than half of the values.
+		for {
+			if n := r.Uint64(); n < width {
+				return int64(uint64(min) + n), nil
+			}
+		}
+	}, nil
+}
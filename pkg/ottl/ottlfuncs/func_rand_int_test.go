@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RandInt(t *testing.T) {
+	tests := []struct {
+		name string
+		min  int64
+		max  int64
+	}{
+		{
+			name: "positive range",
+			min:  10,
+			max:  20,
+		},
+		{
+			name: "negative range",
+			min:  -20,
+			max:  -10,
+		},
+		{
+			name: "range containing zero",
+			min:  -5,
+			max:  5,
+		},
+		{
+			name: "full range",
+			min:  math.MinInt64,
+			max:  math.MaxInt64,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := RandInt[interface{}](tt.min, tt.max)
+			require.NoError(t, err)
+			seen := map[int64]bool{}
+			for i := 0; i < 100; i++ {
+				result, err := exprFunc(context.Background(), nil)
+				require.NoError(t, err)
+				n, ok := result.(int64)
+				require.True(t, ok)
+				assert.GreaterOrEqual(t, n, tt.min)
+				assert.Less(t, n, tt.max)
+				seen[n] = true
+			}
+			assert.Greater(t, len(seen), 1)
+		})
+	}
+}
+
+func Test_RandInt_SingleValue(t *testing.T) {
+	exprFunc, err := RandInt[interface{}](7, 8)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		result, err := exprFunc(context.Background(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), result)
+	}
+}
+
+func Test_RandInt_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		min  int64
+		max  int64
+	}{
+		{
+			name: "min equals max",
+			min:  5,
+			max:  5,
+		},
+		{
+			name: "min greater than max",
+			min:  10,
+			max:  -10,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := RandInt[interface{}](tt.min, tt.max)
+			assert.ErrorContains(t, err, "min must be less than max")
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// Ratio factory function returns the quotient of the numerator and denominator as a float64, even if both numbers
+// are int64. An error is returned if the denominator is zero.
+func Ratio[K any](numerator ottl.Getter[K], denominator ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		numeratorVal, err := numerator.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		denominatorVal, err := denominator.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		a, err := toFloat64(numeratorVal)
+		if err != nil {
+			return nil, err
+		}
+		b, err := toFloat64(denominatorVal)
+		if err != nil {
+			return nil, err
+		}
+		if b == 0 {
+			return nil, errDivisionByZero
+		}
+		return a / b, nil
+	}, nil
+}
@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/publicsuffix"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// RegistrableDomain factory function returns the registrable domain of the target host, i.e. its public suffix and
+// the label before it, e.g. "example.co.uk" for "a.b.example.co.uk", using the Public Suffix List. The host is
+// lowercased and a trailing dot is removed. An error is returned for IP addresses, hosts with invalid characters and
+// hosts without a registrable domain, such as a public suffix itself.
+func RegistrableDomain[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		host, ok := val.(string)
+		if !ok {
+			return nil, fmt.Errorf("target must be a string but got %T", val)
+		}
+		host = strings.ToLower(strings.TrimSuffix(host, "."))
+		if net.ParseIP(host) != nil {
+			return nil, fmt.Errorf("IP address %q has no registrable domain", host)
+		}
+		if host == "" || strings.IndexFunc(host, isInvalidHostRune) >= 0 {
+			return nil, fmt.Errorf("invalid host %q", host)
+		}
+		return publicsuffix.EffectiveTLDPlusOne(host)
+	}, nil
+}
+
+// isInvalidHostRune returns true for the characters that cannot appear in a host name, allowing underscores and
+// internationalized labels.
+func isInvalidHostRune(r rune) bool {
+	return !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_' || r == '.')
+}
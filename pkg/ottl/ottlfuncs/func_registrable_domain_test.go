@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RegistrableDomain(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		expected string
+	}{
+		{
+			name:     "multi-level TLD",
+			target:   "a.b.example.co.uk",
+			expected: "example.co.uk",
+		},
+		{
+			name:     "registrable domain of multi-level TLD",
+			target:   "example.co.uk",
+			expected: "example.co.uk",
+		},
+		{
+			name:     "single-level TLD",
+			target:   "www.example.com",
+			expected: "example.com",
+		},
+		{
+			name:     "wildcard rule",
+			target:   "www.example.foo.ck",
+			expected: "example.foo.ck",
+		},
+		{
+			name:     "private suffix",
+			target:   "docs.user.github.io",
+			expected: "user.github.io",
+		},
+		{
+			name:     "uppercase with trailing dot",
+			target:   "API.Example.COM.",
+			expected: "example.com",
+		},
+		{
+			name:     "underscore label",
+			target:   "_dmarc.example.org",
+			expected: "example.org",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := RegistrableDomain[interface{}](constGetter(tt.target))
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_RegistrableDomain_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   interface{}
+		expected string
+	}{
+		{
+			name:     "IPv4 address",
+			target:   "192.168.1.1",
+			expected: `IP address "192.168.1.1" has no registrable domain`,
+		},
+		{
+			name:     "IPv6 address",
+			target:   "2001:db8::1",
+			expected: `IP address "2001:db8::1" has no registrable domain`,
+		},
+		{
+			name:     "host with port",
+			target:   "example.com:8080",
+			expected: `invalid host "example.com:8080"`,
+		},
+		{
+			name:     "URL",
+			target:   "https://example.com/",
+			expected: `invalid host "https://example.com/"`,
+		},
+		{
+			name:     "empty host",
+			target:   "",
+			expected: `invalid host ""`,
+		},
+		{
+			name:     "public suffix",
+			target:   "co.uk",
+			expected: "publicsuffix: cannot derive eTLD+1 for domain \"co.uk\"",
+		},
+		{
+			name:     "empty label",
+			target:   "www..example.com",
+			expected: "publicsuffix: empty label in domain \"www..example.com\"",
+		},
+		{
+			name:     "not a string",
+			target:   int64(1),
+			expected: "target must be a string but got int64",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := RegistrableDomain[interface{}](constGetter(tt.target))
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
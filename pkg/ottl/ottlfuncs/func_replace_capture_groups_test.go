@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReplaceCaptureGroups(t *testing.T) {
+	tests := []struct {
+		name        string
+		target      string
+		pattern     string
+		replacement string
+		expected    string
+	}{
+		{
+			name:        "numbered backreferences",
+			target:      "range 10-20",
+			pattern:     `(\d+)-(\d+)`,
+			replacement: "$2-$1",
+			expected:    "range 20-10",
+		},
+		{
+			name:        "braced numbered backreference followed by a letter",
+			target:      "10-20",
+			pattern:     `(\d+)-(\d+)`,
+			replacement: "${2}s and ${1}s",
+			expected:    "20s and 10s",
+		},
+		{
+			name:        "named backreferences",
+			target:      "user=alice host=db1",
+			pattern:     `user=(?P<user>\w+) host=(?P<host>\w+)`,
+			replacement: "${user}@${host}",
+			expected:    "alice@db1",
+		},
+		{
+			name:        "literal dollar escape",
+			target:      "price 42",
+			pattern:     `price (\d+)`,
+			replacement: "price $$$1",
+			expected:    "price $42",
+		},
+		{
+			name:        "every match is replaced",
+			target:      "a=1 b=2",
+			pattern:     `(\w)=(\d)`,
+			replacement: "$2=$1",
+			expected:    "1=a 2=b",
+		},
+		{
+			name:        "unknown group expands to empty string",
+			target:      "abc",
+			pattern:     `(b)`,
+			replacement: "[$2]",
+			expected:    "a[]c",
+		},
+		{
+			name:        "no match",
+			target:      "nothing here",
+			pattern:     `(\d+)`,
+			replacement: "<$1>",
+			expected:    "nothing here",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ReplaceCaptureGroups[interface{}](constGetter(tt.target), tt.pattern, tt.replacement)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_ReplaceCaptureGroups_InvalidPattern(t *testing.T) {
+	_, err := ReplaceCaptureGroups[interface{}](constGetter("test"), "(", "$1")
+	assert.ErrorContains(t, err, "the pattern supplied to ReplaceCaptureGroups is not a valid regexp pattern")
+}
+
+func Test_ReplaceCaptureGroups_NotAString(t *testing.T) {
+	exprFunc, err := ReplaceCaptureGroups[interface{}](constGetter(int64(1)), "1", "2")
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.EqualError(t, err, "target must be a string but got int64")
+}
@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// Round factory function returns the target rounded to the given number of decimal places. Halfway values are
+// rounded away from zero. An int64 is returned when decimals is 0, a float64 otherwise.
+func Round[K any](target ottl.Getter[K], decimals int64) (ottl.ExprFunc[K], error) {
+	if decimals < 0 {
+		return nil, errors.New("decimals must not be negative")
+	}
+	scale := math.Pow10(int(decimals))
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		var value float64
+		switch v := val.(type) {
+		case float64:
+			value = v
+		case int64:
+			if decimals == 0 {
+				return v, nil
+			}
+			return float64(v), nil
+		default:
+			return nil, fmt.Errorf("target must be a float64 or int64 but got %T", val)
+		}
+		if decimals == 0 {
+			rounded := math.Round(value)
+			if !inInt64Range(rounded) {
+				return nil, fmt.Errorf("unable to round %v to int: value out of range", value)
+			}
+			return int64(rounded), nil
+		}
+		scaled := value * scale
+		// Values too large to be scaled have no decimals left to round
+		if math.IsInf(scaled, 0) {
+			return value, nil
+		}
+		return math.Round(scaled) / scale, nil
+	}, nil
+}
@@ -0,0 +1,169 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Round(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		decimals int64
+		expected interface{}
+	}{
+		{
+			name:     "round up",
+			value:    2.7,
+			decimals: 0,
+			expected: int64(3),
+		},
+		{
+			name:     "round down",
+			value:    2.3,
+			decimals: 0,
+			expected: int64(2),
+		},
+		{
+			name:     "round half away from zero",
+			value:    2.5,
+			decimals: 0,
+			expected: int64(3),
+		},
+		{
+			name:     "round negative half away from zero",
+			value:    -2.5,
+			decimals: 0,
+			expected: int64(-3),
+		},
+		{
+			name:     "round negative down",
+			value:    -2.3,
+			decimals: 0,
+			expected: int64(-2),
+		},
+		{
+			name:     "round up to 2 decimals",
+			value:    3.14159,
+			decimals: 2,
+			expected: 3.14,
+		},
+		{
+			name:     "round down to 2 decimals",
+			value:    3.14159,
+			decimals: 3,
+			expected: 3.142,
+		},
+		{
+			name:     "round half to 1 decimal",
+			value:    0.25,
+			decimals: 1,
+			expected: 0.3,
+		},
+		{
+			name:     "round negative to 1 decimal",
+			value:    -1.25,
+			decimals: 1,
+			expected: -1.3,
+		},
+		{
+			name:     "int with 0 decimals",
+			value:    int64(42),
+			decimals: 0,
+			expected: int64(42),
+		},
+		{
+			name:     "int with decimals",
+			value:    int64(42),
+			decimals: 2,
+			expected: float64(42),
+		},
+		{
+			name:     "large value",
+			value:    math.MaxFloat64,
+			decimals: 2,
+			expected: math.MaxFloat64,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := Round[interface{}](target, tt.decimals)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Round_Error(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       interface{}
+		expectedErr string
+	}{
+		{
+			name:        "string target",
+			value:       "2.5",
+			expectedErr: "target must be a float64 or int64 but got string",
+		},
+		{
+			name:        "out of int64 range",
+			value:       1e19,
+			expectedErr: "unable to round 1e+19 to int: value out of range",
+		},
+		{
+			name:        "NaN",
+			value:       math.NaN(),
+			expectedErr: "unable to round NaN to int: value out of range",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := Round[interface{}](target, 0)
+			require.NoError(t, err)
+			_, err = exprFunc(nil, nil)
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func Test_Round_NegativeDecimals(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(context.Context, interface{}) (interface{}, error) {
+			return 2.5, nil
+		},
+	}
+	_, err := Round[interface{}](target, -1)
+	assert.EqualError(t, err, "decimals must not be negative")
+}
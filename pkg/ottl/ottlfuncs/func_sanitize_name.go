@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+var nameStyles = map[string]func(string) string{
+	"prometheus": sanitizePrometheusName,
+}
+
+// SanitizeName factory function returns the target string rewritten to a valid name of the style. The only style is
+// "prometheus", which produces a name that is valid both as a Prometheus metric name and as a label name.
+func SanitizeName[K any](target ottl.Getter[K], style string) (ottl.ExprFunc[K], error) {
+	sanitize, ok := nameStyles[style]
+	if !ok {
+		return nil, fmt.Errorf("invalid style %q, allowed styles are: prometheus", style)
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		str, ok := val.(string)
+		if !ok {
+			return nil, fmt.Errorf("target must be a string but got %T", val)
+		}
+		return sanitize(str), nil
+	}, nil
+}
+
+// sanitizePrometheusName replaces each run of characters other than ASCII letters, digits and underscores with a
+// single underscore, collapses repeated underscores and prefixes the name with an underscore if it starts with a digit.
+func sanitizePrometheusName(s string) string {
+	var b strings.Builder
+	b.Grow(len(s) + 1)
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			r = '_'
+		}
+		if b.Len() == 0 && r >= '0' && r <= '9' {
+			b.WriteByte('_')
+		}
+		if r == '_' && strings.HasSuffix(b.String(), "_") {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SanitizeName(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		expected string
+	}{
+		{
+			name:     "dotted OTel name",
+			target:   "http.server.duration",
+			expected: "http_server_duration",
+		},
+		{
+			name:     "slashes and dashes",
+			target:   "system/cpu-time",
+			expected: "system_cpu_time",
+		},
+		{
+			name:     "repeated invalid characters",
+			target:   "a.../b",
+			expected: "a_b",
+		},
+		{
+			name:     "repeated underscores",
+			target:   "a__b._c",
+			expected: "a_b_c",
+		},
+		{
+			name:     "starts with digit",
+			target:   "2xx.count",
+			expected: "_2xx_count",
+		},
+		{
+			name:     "starts with invalid character",
+			target:   ".hidden",
+			expected: "_hidden",
+		},
+		{
+			name:     "colons are replaced",
+			target:   "job:requests:rate5m",
+			expected: "job_requests_rate5m",
+		},
+		{
+			name:     "non-ASCII letters are replaced",
+			target:   "caf\u00e9.latency",
+			expected: "caf_latency",
+		},
+		{
+			name:     "valid name",
+			target:   "process_cpu_seconds_total",
+			expected: "process_cpu_seconds_total",
+		},
+		{
+			name:     "empty string",
+			target:   "",
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := SanitizeName[interface{}](constGetter(tt.target), "prometheus")
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_SanitizeName_Error(t *testing.T) {
+	_, err := SanitizeName[interface{}](constGetter("a.b"), "statsd")
+	assert.EqualError(t, err, `invalid style "statsd", allowed styles are: prometheus`)
+
+	exprFunc, err := SanitizeName[interface{}](constGetter(int64(1)), "prometheus")
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.EqualError(t, err, "target must be a string but got int64")
+}
@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// Scale factory function returns target * factor + offset as a float64, even if the numbers are int64, e.g. to
+// convert a temperature from Celsius to Fahrenheit with a factor of 1.8 and an offset of 32.
+func Scale[K any](target ottl.Getter[K], factor ottl.Getter[K], offset ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		var operands [3]float64
+		for i, getter := range []ottl.Getter[K]{target, factor, offset} {
+			val, err := getter.Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			if operands[i], err = toFloat64(val); err != nil {
+				return nil, err
+			}
+		}
+		return operands[0]*operands[1] + operands[2], nil
+	}, nil
+}
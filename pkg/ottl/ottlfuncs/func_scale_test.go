@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Scale(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   interface{}
+		factor   interface{}
+		offset   interface{}
+		expected float64
+	}{
+		{
+			name:     "pure scale",
+			target:   2.5,
+			factor:   1000.0,
+			offset:   int64(0),
+			expected: 2500,
+		},
+		{
+			name:     "int target promoted to double",
+			target:   int64(3),
+			factor:   int64(2),
+			offset:   int64(0),
+			expected: 6,
+		},
+		{
+			name:     "celsius to fahrenheit",
+			target:   int64(100),
+			factor:   1.8,
+			offset:   int64(32),
+			expected: 212,
+		},
+		{
+			name:     "fahrenheit to celsius",
+			target:   50.0,
+			factor:   5.0 / 9.0,
+			offset:   -160.0 / 9.0,
+			expected: 10,
+		},
+		{
+			name:     "celsius to kelvin",
+			target:   -40.0,
+			factor:   int64(1),
+			offset:   273.15,
+			expected: 233.15,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Scale[interface{}](constGetter(tt.target), constGetter(tt.factor), constGetter(tt.offset))
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expected, result, 1e-9)
+		})
+	}
+}
+
+func Test_Scale_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   interface{}
+		factor   interface{}
+		offset   interface{}
+		expected string
+	}{
+		{
+			name:     "string target",
+			target:   "21.5",
+			factor:   1.8,
+			offset:   32.0,
+			expected: "operands must be int64 or float64 but got string",
+		},
+		{
+			name:     "nil factor",
+			target:   21.5,
+			factor:   nil,
+			offset:   32.0,
+			expected: "operands must be int64 or float64 but got <nil>",
+		},
+		{
+			name:     "bool offset",
+			target:   21.5,
+			factor:   1.8,
+			offset:   true,
+			expected: "operands must be int64 or float64 but got bool",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Scale[interface{}](constGetter(tt.target), constGetter(tt.factor), constGetter(tt.offset))
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
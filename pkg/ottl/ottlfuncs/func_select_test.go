@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_Select(t *testing.T) {
+	input := pcommon.NewMap()
+	require.NoError(t, input.FromRaw(map[string]interface{}{
+		"http.method": "GET",
+		"http.status": int64(200),
+		"user":        map[string]interface{}{"id": "u1"},
+		"debug":       "verbose payload",
+	}))
+
+	tests := []struct {
+		name     string
+		keys     []string
+		expected map[string]interface{}
+	}{
+		{
+			name: "partial overlap",
+			keys: []string{"http.method", "user", "missing"},
+			expected: map[string]interface{}{
+				"http.method": "GET",
+				"user":        map[string]interface{}{"id": "u1"},
+			},
+		},
+		{
+			name:     "no overlap",
+			keys:     []string{"missing", "other"},
+			expected: map[string]interface{}{},
+		},
+		{
+			name:     "empty key list",
+			keys:     []string{},
+			expected: map[string]interface{}{},
+		},
+		{
+			name: "duplicate keys",
+			keys: []string{"http.status", "http.status"},
+			expected: map[string]interface{}{
+				"http.status": int64(200),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Select[interface{}](constGetter(input), tt.keys)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			resultMap, ok := result.(pcommon.Map)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, resultMap.AsRaw())
+			assert.Equal(t, 4, input.Len())
+		})
+	}
+}
+
+func Test_Select_CopiesValues(t *testing.T) {
+	input := pcommon.NewMap()
+	input.PutEmptyMap("user").PutStr("id", "u1")
+
+	exprFunc, err := Select[interface{}](constGetter(input), []string{"user"})
+	require.NoError(t, err)
+	result, err := exprFunc(context.Background(), nil)
+	require.NoError(t, err)
+
+	user, _ := result.(pcommon.Map).Get("user")
+	user.Map().PutStr("id", "u2")
+	original, _ := input.Get("user")
+	assert.Equal(t, map[string]interface{}{"id": "u1"}, original.Map().AsRaw())
+}
+
+func Test_Select_NotAMap(t *testing.T) {
+	exprFunc, err := Select[interface{}](constGetter("string"), []string{"a"})
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.EqualError(t, err, "target must be a map but got string")
+}
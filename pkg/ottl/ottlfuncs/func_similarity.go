@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// Similarity factory function returns a float64 between 0 and 1 describing how similar the a and b strings are,
+// computed as 1 minus their Levenshtein distance divided by the length of the longer string in code points.
+// Two empty strings have a similarity of 1.
+func Similarity[K any](a ottl.Getter[K], b ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		aStr, bStr, err := getStringPair(ctx, tCtx, a, b)
+		if err != nil {
+			return nil, err
+		}
+		aRunes, bRunes := []rune(aStr), []rune(bStr)
+		longest := len(aRunes)
+		if len(bRunes) > longest {
+			longest = len(bRunes)
+		}
+		if longest == 0 {
+			return float64(1), nil
+		}
+		return 1 - float64(levenshtein(aRunes, bRunes))/float64(longest), nil
+	}, nil
+}
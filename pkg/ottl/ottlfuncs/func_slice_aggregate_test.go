@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func newNumberSlice(t *testing.T, numbers ...interface{}) pcommon.Slice {
+	slice := pcommon.NewSlice()
+	require.NoError(t, slice.FromRaw(numbers))
+	return slice
+}
+
+func Test_SliceAggregate(t *testing.T) {
+	tests := []struct {
+		name     string
+		function func(ottl.Getter[interface{}]) (ottl.ExprFunc[interface{}], error)
+		target   []interface{}
+		expected float64
+	}{
+		{
+			name:     "sum of doubles",
+			function: Sum[interface{}],
+			target:   []interface{}{1.5, 2.25, -0.75},
+			expected: 3.0,
+		},
+		{
+			name:     "sum of ints and doubles",
+			function: Sum[interface{}],
+			target:   []interface{}{int64(1), 2.5, int64(3)},
+			expected: 6.5,
+		},
+		{
+			name:     "average",
+			function: Average[interface{}],
+			target:   []interface{}{int64(1), int64(2), int64(3), int64(4)},
+			expected: 2.5,
+		},
+		{
+			name:     "average of single element",
+			function: Average[interface{}],
+			target:   []interface{}{-4.5},
+			expected: -4.5,
+		},
+		{
+			name:     "min",
+			function: SliceMin[interface{}],
+			target:   []interface{}{3.4, int64(-2), 5.6},
+			expected: -2.0,
+		},
+		{
+			name:     "max",
+			function: SliceMax[interface{}],
+			target:   []interface{}{3.4, int64(-2), 5.6},
+			expected: 5.6,
+		},
+		{
+			name:     "max of single element",
+			function: SliceMax[interface{}],
+			target:   []interface{}{int64(7)},
+			expected: 7.0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := tt.function(constGetter(newNumberSlice(t, tt.target...)))
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_SliceAggregate_NaN(t *testing.T) {
+	for _, function := range []func(ottl.Getter[interface{}]) (ottl.ExprFunc[interface{}], error){
+		Sum[interface{}], Average[interface{}], SliceMin[interface{}], SliceMax[interface{}],
+	} {
+		exprFunc, err := function(constGetter(newNumberSlice(t, 1.0, math.NaN())))
+		require.NoError(t, err)
+		result, err := exprFunc(context.Background(), nil)
+		require.NoError(t, err)
+		assert.True(t, math.IsNaN(result.(float64)))
+	}
+}
+
+func Test_SliceAggregate_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   interface{}
+		expected string
+	}{
+		{
+			name:     "empty slice",
+			target:   pcommon.NewSlice(),
+			expected: "target must not be an empty slice",
+		},
+		{
+			name:     "non-numeric element",
+			target:   newNumberSlice(t, 1.0, "2"),
+			expected: "element 1 must be an int64 or float64 but got Str",
+		},
+		{
+			name:     "not a slice",
+			target:   "1.2,3.4",
+			expected: "target must be a slice but got string",
+		},
+	}
+	for _, tt := range tests {
+		for name, function := range map[string]func(ottl.Getter[interface{}]) (ottl.ExprFunc[interface{}], error){
+			"Sum":      Sum[interface{}],
+			"Average":  Average[interface{}],
+			"SliceMin": SliceMin[interface{}],
+			"SliceMax": SliceMax[interface{}],
+		} {
+			t.Run(name+" "+tt.name, func(t *testing.T) {
+				exprFunc, err := function(constGetter(tt.target))
+				require.NoError(t, err)
+				_, err = exprFunc(context.Background(), nil)
+				assert.EqualError(t, err, tt.expected)
+			})
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// SliceIndex factory function returns the element at the index of the target slice, either a pcommon.Slice or the
+// []string returned by Split. Negative indexes count from the end of the slice, e.g. -1 is the last element.
+func SliceIndex[K any](target ottl.Getter[K], index int64) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		targetVal, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		switch slice := targetVal.(type) {
+		case pcommon.Slice:
+			i, err := resolveSliceIndex(index, slice.Len())
+			if err != nil {
+				return nil, err
+			}
+			return fromPcommonValue(slice.At(i)), nil
+		case []string:
+			i, err := resolveSliceIndex(index, len(slice))
+			if err != nil {
+				return nil, err
+			}
+			return slice[i], nil
+		default:
+			return nil, fmt.Errorf("target must be a slice but got %T", targetVal)
+		}
+	}, nil
+}
+
+// resolveSliceIndex returns the non-negative index of the element in a slice of the given length.
+func resolveSliceIndex(index int64, length int) (int, error) {
+	resolved := index
+	if resolved < 0 {
+		resolved += int64(length)
+	}
+	if resolved < 0 || resolved >= int64(length) {
+		return 0, fmt.Errorf("index %d out of range for slice of length %d", index, length)
+	}
+	return int(resolved), nil
+}
+
+// fromPcommonValue returns the pcommon.Value as the type returned by Getters of telemetry fields.
+func fromPcommonValue(v pcommon.Value) interface{} {
+	switch v.Type() {
+	case pcommon.ValueTypeStr:
+		return v.Str()
+	case pcommon.ValueTypeBool:
+		return v.Bool()
+	case pcommon.ValueTypeInt:
+		return v.Int()
+	case pcommon.ValueTypeDouble:
+		return v.Double()
+	case pcommon.ValueTypeMap:
+		return v.Map()
+	case pcommon.ValueTypeSlice:
+		return v.Slice()
+	case pcommon.ValueTypeBytes:
+		return v.Bytes().AsRaw()
+	default:
+		return nil
+	}
+}
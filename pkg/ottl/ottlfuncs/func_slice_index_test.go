@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_SliceIndex(t *testing.T) {
+	target := pcommon.NewSlice()
+	assert.NoError(t, target.FromRaw([]interface{}{"first", int64(2), 3.5, true, []byte{5}, map[string]interface{}{"k": "v"}, []interface{}{"nested"}, nil}))
+	expectedMap := pcommon.NewMap()
+	expectedMap.PutStr("k", "v")
+	expectedSlice := pcommon.NewSlice()
+	expectedSlice.AppendEmpty().SetStr("nested")
+
+	tests := []struct {
+		name     string
+		target   interface{}
+		index    int64
+		expected interface{}
+	}{
+		{
+			name:     "first element",
+			target:   target,
+			index:    0,
+			expected: "first",
+		},
+		{
+			name:     "int element",
+			target:   target,
+			index:    1,
+			expected: int64(2),
+		},
+		{
+			name:     "double element",
+			target:   target,
+			index:    2,
+			expected: 3.5,
+		},
+		{
+			name:     "bool element",
+			target:   target,
+			index:    3,
+			expected: true,
+		},
+		{
+			name:     "bytes element",
+			target:   target,
+			index:    4,
+			expected: []byte{5},
+		},
+		{
+			name:     "map element",
+			target:   target,
+			index:    5,
+			expected: expectedMap,
+		},
+		{
+			name:     "slice element",
+			target:   target,
+			index:    6,
+			expected: expectedSlice,
+		},
+		{
+			name:     "last element with negative index",
+			target:   target,
+			index:    -1,
+			expected: nil,
+		},
+		{
+			name:     "first element with negative index",
+			target:   target,
+			index:    -8,
+			expected: "first",
+		},
+		{
+			name:     "split result",
+			target:   []string{"", "api", "v1", "users"},
+			index:    1,
+			expected: "api",
+		},
+		{
+			name:     "split result with negative index",
+			target:   []string{"", "api", "v1", "users"},
+			index:    -1,
+			expected: "users",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := SliceIndex[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.target, nil
+				},
+			}, tt.index)
+			assert.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_SliceIndex_error(t *testing.T) {
+	target := pcommon.NewSlice()
+	assert.NoError(t, target.FromRaw([]interface{}{"a", "b", "c"}))
+
+	tests := []struct {
+		name        string
+		target      interface{}
+		index       int64
+		expectedErr string
+	}{
+		{
+			name:        "index equal to length",
+			target:      target,
+			index:       3,
+			expectedErr: "index 3 out of range for slice of length 3",
+		},
+		{
+			name:        "negative index beyond start",
+			target:      target,
+			index:       -4,
+			expectedErr: "index -4 out of range for slice of length 3",
+		},
+		{
+			name:        "empty slice",
+			target:      []string{},
+			index:       0,
+			expectedErr: "index 0 out of range for slice of length 0",
+		},
+		{
+			name:        "not a slice",
+			target:      "a,b,c",
+			index:       0,
+			expectedErr: "target must be a slice but got string",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := SliceIndex[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.target, nil
+				},
+			}, tt.index)
+			assert.NoError(t, err)
+			_, err = exprFunc(nil, nil)
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
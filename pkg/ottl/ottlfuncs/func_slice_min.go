@@ -0,0 +1,34 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"math"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// SliceMin factory function returns the smallest number of the target `pcommon.Slice` as a float64. The result is
+// NaN if any number is NaN. An error is returned if the slice is empty or has an element that is not an int64 or a
+// float64.
+func SliceMin[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return sliceAggregate(target, func(numbers []float64) float64 {
+		result := numbers[0]
+		for _, n := range numbers[1:] {
+			result = math.Min(result, n)
+		}
+		return result
+	}), nil
+}
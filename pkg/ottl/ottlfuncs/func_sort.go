@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+const (
+	sortAscending  = "asc"
+	sortDescending = "desc"
+)
+
+// Sort factory function returns a copy of the target slice sorted in the given order, either "asc" or "desc".
+// Elements of different types are sorted by type first: booleans, numbers, strings, bytes and then the other
+// types, e.g. maps, which keep their relative order. Ints and doubles are both numbers and compared by value,
+// NaN sorts before the other numbers.
+func Sort[K any](target ottl.Getter[K], order string) (ottl.ExprFunc[K], error) {
+	if order != sortAscending && order != sortDescending {
+		return nil, fmt.Errorf("invalid order %q, must be %q or %q", order, sortAscending, sortDescending)
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		targetVal, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		slice, ok := targetVal.(pcommon.Slice)
+		if !ok {
+			return nil, fmt.Errorf("target must be a slice but got %T", targetVal)
+		}
+
+		indexes := make([]int, slice.Len())
+		for i := range indexes {
+			indexes[i] = i
+		}
+		sort.SliceStable(indexes, func(i, j int) bool {
+			if order == sortDescending {
+				return lessValue(slice.At(indexes[j]), slice.At(indexes[i]))
+			}
+			return lessValue(slice.At(indexes[i]), slice.At(indexes[j]))
+		})
+
+		result := pcommon.NewSlice()
+		result.EnsureCapacity(slice.Len())
+		for _, i := range indexes {
+			slice.At(i).CopyTo(result.AppendEmpty())
+		}
+		return result, nil
+	}, nil
+}
+
+// sortTypeRank returns the rank of the type of the value in the sort order.
+func sortTypeRank(v pcommon.Value) int {
+	switch v.Type() {
+	case pcommon.ValueTypeBool:
+		return 0
+	case pcommon.ValueTypeInt, pcommon.ValueTypeDouble:
+		return 1
+	case pcommon.ValueTypeStr:
+		return 2
+	case pcommon.ValueTypeBytes:
+		return 3
+	default:
+		return 4
+	}
+}
+
+// lessValue reports whether the value a sorts before the value b.
+func lessValue(a, b pcommon.Value) bool {
+	rankA, rankB := sortTypeRank(a), sortTypeRank(b)
+	if rankA != rankB {
+		return rankA < rankB
+	}
+	switch rankA {
+	case 0:
+		return !a.Bool() && b.Bool()
+	case 1:
+		if a.Type() == pcommon.ValueTypeInt && b.Type() == pcommon.ValueTypeInt {
+			return a.Int() < b.Int()
+		}
+		numberA, numberB := numberValue(a), numberValue(b)
+		// NaN sorts before the other numbers to keep the order consistent
+		if math.IsNaN(numberA) {
+			return !math.IsNaN(numberB)
+		}
+		return numberA < numberB
+	case 2:
+		return a.Str() < b.Str()
+	case 3:
+		return bytes.Compare(a.Bytes().AsRaw(), b.Bytes().AsRaw()) < 0
+	default:
+		return false
+	}
+}
+
+// numberValue returns the int or double value as a float64.
+func numberValue(v pcommon.Value) float64 {
+	if v.Type() == pcommon.ValueTypeInt {
+		return float64(v.Int())
+	}
+	return v.Double()
+}
@@ -0,0 +1,167 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Sort(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []interface{}
+		order    string
+		expected []interface{}
+	}{
+		{
+			name:     "strings",
+			values:   []interface{}{"pear", "apple", "fig", "Banana"},
+			order:    "asc",
+			expected: []interface{}{"Banana", "apple", "fig", "pear"},
+		},
+		{
+			name:     "strings descending",
+			values:   []interface{}{"pear", "apple", "fig", "Banana"},
+			order:    "desc",
+			expected: []interface{}{"pear", "fig", "apple", "Banana"},
+		},
+		{
+			name:     "ints",
+			values:   []interface{}{int64(10), int64(-2), int64(3), int64(3)},
+			order:    "asc",
+			expected: []interface{}{int64(-2), int64(3), int64(3), int64(10)},
+		},
+		{
+			name:     "ints descending",
+			values:   []interface{}{int64(10), int64(-2), int64(3)},
+			order:    "desc",
+			expected: []interface{}{int64(10), int64(3), int64(-2)},
+		},
+		{
+			name:     "large ints",
+			values:   []interface{}{int64(math.MaxInt64), int64(math.MaxInt64 - 1)},
+			order:    "asc",
+			expected: []interface{}{int64(math.MaxInt64 - 1), int64(math.MaxInt64)},
+		},
+		{
+			name:     "doubles",
+			values:   []interface{}{2.5, -1.5, 0.0},
+			order:    "asc",
+			expected: []interface{}{-1.5, 0.0, 2.5},
+		},
+		{
+			name:     "doubles descending",
+			values:   []interface{}{2.5, -1.5, 0.0},
+			order:    "desc",
+			expected: []interface{}{2.5, 0.0, -1.5},
+		},
+		{
+			name:     "ints and doubles",
+			values:   []interface{}{2.5, int64(2), int64(3), 1.0},
+			order:    "asc",
+			expected: []interface{}{1.0, int64(2), 2.5, int64(3)},
+		},
+		{
+			name:     "booleans",
+			values:   []interface{}{true, false, true},
+			order:    "asc",
+			expected: []interface{}{false, true, true},
+		},
+		{
+			name:     "mixed types",
+			values:   []interface{}{map[string]interface{}{"a": "b"}, "b", int64(1), []byte{1}, true, "a", 0.5, nil},
+			order:    "asc",
+			expected: []interface{}{true, 0.5, int64(1), "a", "b", []byte{1}, map[string]interface{}{"a": "b"}, nil},
+		},
+		{
+			name:     "mixed types descending",
+			values:   []interface{}{map[string]interface{}{"a": "b"}, "b", int64(1), []byte{1}, true, "a", 0.5, nil},
+			order:    "desc",
+			expected: []interface{}{map[string]interface{}{"a": "b"}, nil, []byte{1}, "b", "a", int64(1), 0.5, true},
+		},
+		{
+			name:     "empty",
+			values:   []interface{}{},
+			order:    "asc",
+			expected: []interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := pcommon.NewSlice()
+			assert.NoError(t, target.FromRaw(tt.values))
+			original := pcommon.NewSlice()
+			target.CopyTo(original)
+
+			exprFunc, err := Sort[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return target, nil
+				},
+			}, tt.order)
+			assert.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			assert.NoError(t, err)
+
+			expected := pcommon.NewSlice()
+			assert.NoError(t, expected.FromRaw(tt.expected))
+			assert.Equal(t, expected, result)
+			// The target is not modified
+			assert.Equal(t, original, target)
+		})
+	}
+}
+
+func Test_Sort_NaN(t *testing.T) {
+	target := pcommon.NewSlice()
+	assert.NoError(t, target.FromRaw([]interface{}{1.5, math.NaN(), -1.5}))
+
+	exprFunc, err := Sort[interface{}](&ottl.StandardGetSetter[interface{}]{
+		Getter: func(context.Context, interface{}) (interface{}, error) {
+			return target, nil
+		},
+	}, "asc")
+	assert.NoError(t, err)
+	result, err := exprFunc(nil, nil)
+	assert.NoError(t, err)
+
+	sorted := result.(pcommon.Slice)
+	assert.Equal(t, 3, sorted.Len())
+	assert.True(t, math.IsNaN(sorted.At(0).Double()))
+	assert.Equal(t, -1.5, sorted.At(1).Double())
+	assert.Equal(t, 1.5, sorted.At(2).Double())
+}
+
+func Test_Sort_error(t *testing.T) {
+	exprFunc, err := Sort[interface{}](&ottl.StandardGetSetter[interface{}]{
+		Getter: func(context.Context, interface{}) (interface{}, error) {
+			return "not a slice", nil
+		},
+	}, "asc")
+	assert.NoError(t, err)
+	_, err = exprFunc(nil, nil)
+	assert.Error(t, err)
+}
+
+func Test_Sort_invalid_order(t *testing.T) {
+	_, err := Sort[interface{}](&ottl.StandardGetSetter[interface{}]{}, "ascending")
+	assert.Error(t, err)
+}
@@ -0,0 +1,189 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{
+			name:     "string",
+			value:    "hello world",
+			expected: "hello world",
+		},
+		{
+			name:     "empty string",
+			value:    "",
+			expected: "",
+		},
+		{
+			name:     "bool",
+			value:    true,
+			expected: "true",
+		},
+		{
+			name:     "int64",
+			value:    int64(-42),
+			expected: "-42",
+		},
+		{
+			name:     "float64",
+			value:    2.5,
+			expected: "2.5",
+		},
+		{
+			name:     "large float64",
+			value:    float64(1e21),
+			expected: "1000000000000000000000",
+		},
+		{
+			name:     "bytes",
+			value:    []byte{1, 2, 3, 4},
+			expected: "AQIDBA==",
+		},
+		{
+			name:     "empty bytes",
+			value:    []byte{},
+			expected: "",
+		},
+		{
+			name: "map",
+			value: func() pcommon.Map {
+				m := pcommon.NewMap()
+				m.PutStr("test", "value")
+				m.PutInt("num", 1)
+				m.PutEmptySlice("list").AppendEmpty().SetBool(true)
+				return m
+			}(),
+			expected: `{"list":[true],"num":1,"test":"value"}`,
+		},
+		{
+			name:     "empty map",
+			value:    pcommon.NewMap(),
+			expected: "{}",
+		},
+		{
+			name: "slice",
+			value: func() pcommon.Slice {
+				s := pcommon.NewSlice()
+				s.AppendEmpty().SetStr("a")
+				s.AppendEmpty().SetDouble(1.5)
+				s.AppendEmpty().SetEmptyMap().PutStr("b", "c")
+				return s
+			}(),
+			expected: `["a",1.5,{"b":"c"}]`,
+		},
+		{
+			name:     "empty slice",
+			value:    pcommon.NewSlice(),
+			expected: "[]",
+		},
+		{
+			name:     "pcommon str value",
+			value:    pcommon.NewValueStr("hello"),
+			expected: "hello",
+		},
+		{
+			name:     "pcommon bool value",
+			value:    pcommon.NewValueBool(false),
+			expected: "false",
+		},
+		{
+			name:     "pcommon int value",
+			value:    pcommon.NewValueInt(7),
+			expected: "7",
+		},
+		{
+			name:     "pcommon double value",
+			value:    pcommon.NewValueDouble(1.25),
+			expected: "1.25",
+		},
+		{
+			name: "pcommon bytes value",
+			value: func() pcommon.Value {
+				v := pcommon.NewValueBytes()
+				v.Bytes().FromRaw([]byte{1, 2, 3, 4})
+				return v
+			}(),
+			expected: "AQIDBA==",
+		},
+		{
+			name: "pcommon map value",
+			value: func() pcommon.Value {
+				v := pcommon.NewValueMap()
+				v.Map().PutStr("test", "value")
+				return v
+			}(),
+			expected: `{"test":"value"}`,
+		},
+		{
+			name: "pcommon slice value",
+			value: func() pcommon.Value {
+				v := pcommon.NewValueSlice()
+				v.Slice().AppendEmpty().SetInt(1)
+				v.Slice().AppendEmpty().SetStr("a")
+				return v
+			}(),
+			expected: `[1,"a"]`,
+		},
+		{
+			name:     "empty pcommon value",
+			value:    pcommon.NewValueEmpty(),
+			expected: "",
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := String[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			})
+			assert.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_String_error(t *testing.T) {
+	exprFunc, err := String[interface{}](&ottl.StandardGetSetter[interface{}]{
+		Getter: func(context.Context, interface{}) (interface{}, error) {
+			return struct{}{}, nil
+		},
+	})
+	assert.NoError(t, err)
+	result, err := exprFunc(nil, nil)
+	assert.EqualError(t, err, "unsupported type struct {}")
+	assert.Nil(t, result)
+}
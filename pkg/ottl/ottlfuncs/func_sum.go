@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+var errEmptySlice = errors.New("target must not be an empty slice")
+
+// Sum factory function returns the sum of the numbers of the target `pcommon.Slice` as a float64. An error is
+// returned if the slice is empty or has an element that is not an int64 or a float64.
+func Sum[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return sliceAggregate(target, func(numbers []float64) float64 {
+		var sum float64
+		for _, n := range numbers {
+			sum += n
+		}
+		return sum
+	}), nil
+}
+
+// sliceAggregate returns an ExprFunc that applies aggregate to the numbers of the target slice as float64.
+func sliceAggregate[K any](target ottl.Getter[K], aggregate func([]float64) float64) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		slice, ok := val.(pcommon.Slice)
+		if !ok {
+			return nil, fmt.Errorf("target must be a slice but got %T", val)
+		}
+		if slice.Len() == 0 {
+			return nil, errEmptySlice
+		}
+		numbers := make([]float64, slice.Len())
+		for i := 0; i < slice.Len(); i++ {
+			v := slice.At(i)
+			switch v.Type() {
+			case pcommon.ValueTypeInt:
+				numbers[i] = float64(v.Int())
+			case pcommon.ValueTypeDouble:
+				numbers[i] = v.Double()
+			default:
+				return nil, fmt.Errorf("element %d must be an int64 or float64 but got %v", i, v.Type())
+			}
+		}
+		return aggregate(numbers), nil
+	}
+}
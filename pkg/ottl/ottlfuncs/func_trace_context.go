@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// TraceContext factory function returns the trace and span IDs as a `<trace_id>-<span_id>` string of lowercase hex
+// digits, or nil if either ID is empty. The IDs are either `pcommon.TraceID` and `pcommon.SpanID` values, e.g. the
+// `trace_id` and `span_id` paths, or their hex string representations, e.g. the `trace_id.string` and
+// `span_id.string` paths.
+func TraceContext[K any](traceID ottl.Getter[K], spanID ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		traceIDVal, err := traceID.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		traceIDStr, err := idToHex(traceIDVal, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trace ID: %w", err)
+		}
+		spanIDVal, err := spanID.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		spanIDStr, err := idToHex(spanIDVal, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid span ID: %w", err)
+		}
+		if traceIDStr == "" || spanIDStr == "" {
+			return nil, nil
+		}
+		return traceIDStr + "-" + spanIDStr, nil
+	}, nil
+}
+
+// idToHex returns the ID of the given size in bytes as lowercase hex digits, or an empty string if it is empty.
+func idToHex(val interface{}, size int) (string, error) {
+	var id []byte
+	switch v := val.(type) {
+	case pcommon.TraceID:
+		id = v[:]
+	case pcommon.SpanID:
+		id = v[:]
+	case string:
+		decoded, err := hex.DecodeString(v)
+		if err != nil {
+			return "", err
+		}
+		id = decoded
+	default:
+		return "", fmt.Errorf("must be an ID or a hex string but got %T", val)
+	}
+	if len(id) != size {
+		return "", fmt.Errorf("must be %d bytes but got %d", size, len(id))
+	}
+	str := hex.EncodeToString(id)
+	if strings.Trim(str, "0") == "" {
+		return "", nil
+	}
+	return str, nil
+}
@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_TraceContext(t *testing.T) {
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	spanID := pcommon.SpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+
+	tests := []struct {
+		name     string
+		traceID  interface{}
+		spanID   interface{}
+		expected interface{}
+	}{
+		{
+			name:     "populated IDs",
+			traceID:  traceID,
+			spanID:   spanID,
+			expected: "0102030405060708090a0b0c0d0e0f10-0102030405060708",
+		},
+		{
+			name:     "populated hex strings",
+			traceID:  "0102030405060708090A0B0C0D0E0F10",
+			spanID:   "0102030405060708",
+			expected: "0102030405060708090a0b0c0d0e0f10-0102030405060708",
+		},
+		{
+			name:     "empty IDs",
+			traceID:  pcommon.NewTraceIDEmpty(),
+			spanID:   pcommon.NewSpanIDEmpty(),
+			expected: nil,
+		},
+		{
+			name:     "empty span ID",
+			traceID:  traceID,
+			spanID:   pcommon.NewSpanIDEmpty(),
+			expected: nil,
+		},
+		{
+			name:     "zero hex strings",
+			traceID:  "00000000000000000000000000000000",
+			spanID:   "0000000000000000",
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := TraceContext[interface{}](constGetter(tt.traceID), constGetter(tt.spanID))
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_TraceContext_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		traceID  interface{}
+		spanID   interface{}
+		expected string
+	}{
+		{
+			name:     "span ID as trace ID",
+			traceID:  pcommon.SpanID([8]byte{1}),
+			spanID:   pcommon.SpanID([8]byte{1}),
+			expected: "invalid trace ID: must be 16 bytes but got 8",
+		},
+		{
+			name:     "invalid hex",
+			traceID:  pcommon.TraceID([16]byte{1}),
+			spanID:   "not hex!",
+			expected: "invalid span ID: encoding/hex: invalid byte: U+006E 'n'",
+		},
+		{
+			name:     "unsupported type",
+			traceID:  int64(1),
+			spanID:   pcommon.SpanID([8]byte{1}),
+			expected: "invalid trace ID: must be an ID or a hex string but got int64",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := TraceContext[interface{}](constGetter(tt.traceID), constGetter(tt.spanID))
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
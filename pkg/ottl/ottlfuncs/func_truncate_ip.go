@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// TruncateIP factory function returns the target IP address with all the bits after the prefix length set to zero,
+// e.g. "192.168.1.37" truncated to 24 bits is "192.168.1.0". IPv4 addresses support prefix lengths up to 32 and
+// IPv6 addresses up to 128.
+func TruncateIP[K any](target ottl.Getter[K], prefixLength int64) (ottl.ExprFunc[K], error) {
+	if prefixLength < 0 || prefixLength > 8*net.IPv6len {
+		return nil, fmt.Errorf("prefix length must be between 0 and %d but got %d", 8*net.IPv6len, prefixLength)
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		ipStr, ok := val.(string)
+		if !ok {
+			return nil, fmt.Errorf("target must be a string but got %T", val)
+		}
+		ip := net.ParseIP(ipStr)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid IP address %q", ipStr)
+		}
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 8*net.IPv4len
+		}
+		if prefixLength > int64(bits) {
+			return nil, fmt.Errorf("prefix length %d exceeds the %d bits of IP address %q", prefixLength, bits, ipStr)
+		}
+		return ip.Mask(net.CIDRMask(int(prefixLength), bits)).String(), nil
+	}, nil
+}
@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_TruncateIP(t *testing.T) {
+	tests := []struct {
+		name         string
+		value        interface{}
+		prefixLength int64
+		expected     string
+	}{
+		{
+			name:         "IPv4 /24",
+			value:        "192.168.1.37",
+			prefixLength: 24,
+			expected:     "192.168.1.0",
+		},
+		{
+			name:         "IPv4 /16",
+			value:        "192.168.1.37",
+			prefixLength: 16,
+			expected:     "192.168.0.0",
+		},
+		{
+			name:         "IPv4 /20",
+			value:        "10.1.255.37",
+			prefixLength: 20,
+			expected:     "10.1.240.0",
+		},
+		{
+			name:         "IPv4 /32",
+			value:        "192.168.1.37",
+			prefixLength: 32,
+			expected:     "192.168.1.37",
+		},
+		{
+			name:         "IPv4 /0",
+			value:        "192.168.1.37",
+			prefixLength: 0,
+			expected:     "0.0.0.0",
+		},
+		{
+			name:         "IPv6 /64",
+			value:        "2001:db8:85a3:1234:8a2e:370:7334:1",
+			prefixLength: 64,
+			expected:     "2001:db8:85a3:1234::",
+		},
+		{
+			name:         "IPv6 /48",
+			value:        "2001:db8:85a3:1234::1",
+			prefixLength: 48,
+			expected:     "2001:db8:85a3::",
+		},
+		{
+			name:         "IPv6 /128",
+			value:        "fe80::1",
+			prefixLength: 128,
+			expected:     "fe80::1",
+		},
+		{
+			name:         "IPv4-mapped IPv6",
+			value:        "::ffff:192.168.1.37",
+			prefixLength: 24,
+			expected:     "192.168.1.0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := TruncateIP[interface{}](target, tt.prefixLength)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_TruncateIP_Error(t *testing.T) {
+	tests := []struct {
+		name         string
+		value        interface{}
+		prefixLength int64
+		expectedErr  string
+	}{
+		{
+			name:         "non-string target",
+			value:        int64(1),
+			prefixLength: 24,
+			expectedErr:  "target must be a string but got int64",
+		},
+		{
+			name:         "invalid IP address",
+			value:        "192.168.1",
+			prefixLength: 24,
+			expectedErr:  `invalid IP address "192.168.1"`,
+		},
+		{
+			name:         "IPv4 prefix length too long",
+			value:        "192.168.1.37",
+			prefixLength: 64,
+			expectedErr:  `prefix length 64 exceeds the 32 bits of IP address "192.168.1.37"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := TruncateIP[interface{}](target, tt.prefixLength)
+			require.NoError(t, err)
+			_, err = exprFunc(nil, nil)
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func Test_TruncateIP_InvalidPrefixLength(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(context.Context, interface{}) (interface{}, error) {
+			return "192.168.1.37", nil
+		},
+	}
+	for _, prefixLength := range []int64{-1, 129} {
+		_, err := TruncateIP[interface{}](target, prefixLength)
+		assert.EqualError(t, err, fmt.Sprintf("prefix length must be between 0 and 128 but got %d", prefixLength))
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_TruncateTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		duration string
+		expected time.Time
+	}{
+		{
+			name:     "hour",
+			value:    time.Date(2023, 1, 2, 15, 4, 5, 123, time.UTC),
+			duration: "1h",
+			expected: time.Date(2023, 1, 2, 15, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "hour on a boundary",
+			value:    time.Date(2023, 1, 2, 15, 0, 0, 0, time.UTC),
+			duration: "1h",
+			expected: time.Date(2023, 1, 2, 15, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "just before an hour boundary",
+			value:    time.Date(2023, 1, 2, 15, 59, 59, 999999999, time.UTC),
+			duration: "1h",
+			expected: time.Date(2023, 1, 2, 15, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "minute",
+			value:    time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC),
+			duration: "1m",
+			expected: time.Date(2023, 1, 2, 15, 4, 0, 0, time.UTC),
+		},
+		{
+			name:     "minute on a boundary",
+			value:    time.Date(2023, 1, 2, 15, 4, 0, 0, time.UTC),
+			duration: "1m",
+			expected: time.Date(2023, 1, 2, 15, 4, 0, 0, time.UTC),
+		},
+		{
+			name:     "15 minutes",
+			value:    time.Date(2023, 1, 2, 15, 44, 5, 0, time.UTC),
+			duration: "15m",
+			expected: time.Date(2023, 1, 2, 15, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "timestamp",
+			value:    pcommon.NewTimestampFromTime(time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC)),
+			duration: "1h",
+			expected: time.Date(2023, 1, 2, 15, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "unix nanoseconds",
+			value:    time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC).UnixNano(),
+			duration: "1m",
+			expected: time.Date(2023, 1, 2, 15, 4, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := TruncateTime[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}, tt.duration)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result.(time.Time)), "expected %v but got %v", tt.expected, result)
+		})
+	}
+}
+
+func Test_TruncateTime_error(t *testing.T) {
+	exprFunc, err := TruncateTime[interface{}](&ottl.StandardGetSetter[interface{}]{
+		Getter: func(context.Context, interface{}) (interface{}, error) {
+			return "2023-01-02T15:04:05Z", nil
+		},
+	}, "1h")
+	require.NoError(t, err)
+	_, err = exprFunc(nil, nil)
+	assert.Error(t, err)
+}
+
+func Test_TruncateTime_invalid_duration(t *testing.T) {
+	for _, duration := range []string{"", "hour", "0s", "-1h"} {
+		_, err := TruncateTime[interface{}](&ottl.StandardGetSetter[interface{}]{}, duration)
+		assert.Error(t, err, duration)
+	}
+}
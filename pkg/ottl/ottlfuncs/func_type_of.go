@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+var valueTypeNames = map[pcommon.ValueType]string{
+	pcommon.ValueTypeEmpty:  "empty",
+	pcommon.ValueTypeStr:    "string",
+	pcommon.ValueTypeInt:    "int",
+	pcommon.ValueTypeDouble: "double",
+	pcommon.ValueTypeBool:   "bool",
+	pcommon.ValueTypeMap:    "map",
+	pcommon.ValueTypeSlice:  "slice",
+	pcommon.ValueTypeBytes:  "bytes",
+}
+
+// TypeOf factory function returns the name of the type of the target value: "empty", "string", "int", "double",
+// "bool", "map", "slice" or "bytes". Values that cannot be represented as a `pcommon.Value`, e.g. a trace ID, return
+// their Go type name.
+func TypeOf[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		switch v := val.(type) {
+		case pcommon.Value:
+			return valueTypeNames[v.Type()], nil
+		case pcommon.Map:
+			return valueTypeNames[pcommon.ValueTypeMap], nil
+		case pcommon.Slice:
+			return valueTypeNames[pcommon.ValueTypeSlice], nil
+		}
+		value, err := toPcommonValue(val)
+		if err != nil {
+			return fmt.Sprintf("%T", val), nil
+		}
+		return valueTypeNames[value.Type()], nil
+	}, nil
+}
@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_TypeOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   interface{}
+		expected string
+	}{
+		{
+			name:     "nil",
+			target:   nil,
+			expected: "empty",
+		},
+		{
+			name:     "string",
+			target:   "hello",
+			expected: "string",
+		},
+		{
+			name:     "int",
+			target:   int64(1),
+			expected: "int",
+		},
+		{
+			name:     "double",
+			target:   1.5,
+			expected: "double",
+		},
+		{
+			name:     "bool",
+			target:   true,
+			expected: "bool",
+		},
+		{
+			name:     "bytes",
+			target:   []byte{1, 2},
+			expected: "bytes",
+		},
+		{
+			name:     "map",
+			target:   pcommon.NewMap(),
+			expected: "map",
+		},
+		{
+			name:     "slice",
+			target:   pcommon.NewSlice(),
+			expected: "slice",
+		},
+		{
+			name:     "raw map",
+			target:   map[string]interface{}{"a": 1},
+			expected: "map",
+		},
+		{
+			name:     "raw slice",
+			target:   []interface{}{"a"},
+			expected: "slice",
+		},
+		{
+			name:     "empty value",
+			target:   pcommon.NewValueEmpty(),
+			expected: "empty",
+		},
+		{
+			name:     "string value",
+			target:   pcommon.NewValueStr("hello"),
+			expected: "string",
+		},
+		{
+			name:     "int value",
+			target:   pcommon.NewValueInt(1),
+			expected: "int",
+		},
+		{
+			name:     "double value",
+			target:   pcommon.NewValueDouble(1.5),
+			expected: "double",
+		},
+		{
+			name:     "bool value",
+			target:   pcommon.NewValueBool(false),
+			expected: "bool",
+		},
+		{
+			name:     "map value",
+			target:   pcommon.NewValueMap(),
+			expected: "map",
+		},
+		{
+			name:     "slice value",
+			target:   pcommon.NewValueSlice(),
+			expected: "slice",
+		},
+		{
+			name:     "bytes value",
+			target:   pcommon.NewValueBytes(),
+			expected: "bytes",
+		},
+		{
+			name:     "trace ID",
+			target:   pcommon.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+			expected: "pcommon.TraceID",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := TypeOf[interface{}](constGetter(tt.target))
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Unix(t *testing.T) {
+	instant := time.Date(2023, 1, 2, 15, 4, 5, 123456789, time.UTC)
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		value           interface{}
+		expectedSeconds int64
+		expectedMilli   int64
+		expectedNano    int64
+	}{
+		{
+			name:            "time",
+			value:           instant,
+			expectedSeconds: 1672671845,
+			expectedMilli:   1672671845123,
+			expectedNano:    1672671845123456789,
+		},
+		{
+			name:            "time in another location",
+			value:           instant.In(newYork),
+			expectedSeconds: 1672671845,
+			expectedMilli:   1672671845123,
+			expectedNano:    1672671845123456789,
+		},
+		{
+			name:            "timestamp",
+			value:           pcommon.NewTimestampFromTime(instant),
+			expectedSeconds: 1672671845,
+			expectedMilli:   1672671845123,
+			expectedNano:    1672671845123456789,
+		},
+		{
+			name:            "unix nanoseconds",
+			value:           int64(1672671845123456789),
+			expectedSeconds: 1672671845,
+			expectedMilli:   1672671845123,
+			expectedNano:    1672671845123456789,
+		},
+		{
+			name:            "before the epoch",
+			value:           time.Date(1969, 12, 31, 23, 59, 59, 0, time.UTC),
+			expectedSeconds: -1,
+			expectedMilli:   -1000,
+			expectedNano:    -1000000000,
+		},
+		{
+			name:            "zero time",
+			value:           time.Time{},
+			expectedSeconds: 0,
+			expectedMilli:   0,
+			expectedNano:    0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getter := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+
+			secondsFunc, err := UnixSeconds[interface{}](getter)
+			require.NoError(t, err)
+			seconds, err := secondsFunc(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedSeconds, seconds)
+
+			milliFunc, err := UnixMilli[interface{}](getter)
+			require.NoError(t, err)
+			milli, err := milliFunc(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedMilli, milli)
+
+			nanoFunc, err := UnixNano[interface{}](getter)
+			require.NoError(t, err)
+			nano, err := nanoFunc(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedNano, nano)
+
+			// The values are truncated multiples of each other
+			assert.Equal(t, seconds.(int64)*1000, milli.(int64)-milli.(int64)%1000)
+			assert.Equal(t, milli.(int64)*1000000, nano.(int64)-nano.(int64)%1000000)
+		})
+	}
+}
+
+func Test_Unix_error(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{
+			name:  "string",
+			value: "2023-01-02T15:04:05Z",
+		},
+		{
+			name:  "double",
+			value: 1672671845.5,
+		},
+		{
+			name:  "nil",
+			value: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getter := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			for _, factory := range []func(ottl.Getter[interface{}]) (ottl.ExprFunc[interface{}], error){
+				UnixSeconds[interface{}],
+				UnixMilli[interface{}],
+				UnixNano[interface{}],
+			} {
+				exprFunc, err := factory(getter)
+				require.NoError(t, err)
+				_, err = exprFunc(nil, nil)
+				assert.Error(t, err)
+			}
+		})
+	}
+}
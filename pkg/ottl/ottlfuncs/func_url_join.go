@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// URLJoin factory function returns the ref URL resolved against the base URL as specified by RFC 3986, section 5.2.
+// An absolute ref is returned as is, other refs replace the path, query or fragment of the base URL.
+func URLJoin[K any](base ottl.Getter[K], ref ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		baseVal, err := base.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		baseStr, ok := baseVal.(string)
+		if !ok {
+			return nil, fmt.Errorf("base must be a string but got %T", baseVal)
+		}
+		refVal, err := ref.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		refStr, ok := refVal.(string)
+		if !ok {
+			return nil, fmt.Errorf("ref must be a string but got %T", refVal)
+		}
+		baseURL, err := url.Parse(baseStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base URL: %w", err)
+		}
+		refURL, err := url.Parse(refStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ref URL: %w", err)
+		}
+		result := baseURL.ResolveReference(refURL)
+		// RFC 3986 always takes the fragment from the ref, while ResolveReference keeps the fragment of the base for an
+		// empty ref.
+		result.Fragment = refURL.Fragment
+		result.RawFragment = refURL.RawFragment
+		return result.String(), nil
+	}, nil
+}
@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_URLJoin(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     string
+		ref      string
+		expected string
+	}{
+		{
+			name:     "absolute ref",
+			base:     "https://example.com/api/v1/",
+			ref:      "http://other.example.com/path",
+			expected: "http://other.example.com/path",
+		},
+		{
+			name:     "network-path ref",
+			base:     "https://example.com/api/v1/",
+			ref:      "//cdn.example.com/static/app.js",
+			expected: "https://cdn.example.com/static/app.js",
+		},
+		{
+			name:     "absolute-path ref",
+			base:     "https://example.com/api/v1/users",
+			ref:      "/health",
+			expected: "https://example.com/health",
+		},
+		{
+			name:     "relative ref against directory",
+			base:     "https://example.com/api/v1/",
+			ref:      "users/42",
+			expected: "https://example.com/api/v1/users/42",
+		},
+		{
+			name:     "relative ref against file",
+			base:     "https://example.com/api/v1/users",
+			ref:      "orders",
+			expected: "https://example.com/api/v1/orders",
+		},
+		{
+			name:     "dot segments",
+			base:     "https://example.com/api/v1/users/",
+			ref:      "../../v2/./users",
+			expected: "https://example.com/api/v2/users",
+		},
+		{
+			name:     "relative ref with query",
+			base:     "https://example.com/api/v1/?debug=true",
+			ref:      "search?q=otel&page=2",
+			expected: "https://example.com/api/v1/search?q=otel&page=2",
+		},
+		{
+			name:     "query-only ref",
+			base:     "https://example.com/api/v1/search?q=old#results",
+			ref:      "?q=new",
+			expected: "https://example.com/api/v1/search?q=new",
+		},
+		{
+			name:     "fragment-only ref",
+			base:     "https://example.com/docs?lang=en",
+			ref:      "#install",
+			expected: "https://example.com/docs?lang=en#install",
+		},
+		{
+			name:     "empty ref",
+			base:     "https://example.com/docs?lang=en#install",
+			ref:      "",
+			expected: "https://example.com/docs?lang=en",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := URLJoin[interface{}](constGetter(tt.base), constGetter(tt.ref))
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_URLJoin_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     interface{}
+		ref      interface{}
+		expected string
+	}{
+		{
+			name:     "base is not a string",
+			base:     int64(1),
+			ref:      "/path",
+			expected: "base must be a string but got int64",
+		},
+		{
+			name:     "ref is not a string",
+			base:     "https://example.com",
+			ref:      nil,
+			expected: "ref must be a string but got <nil>",
+		},
+		{
+			name:     "invalid base",
+			base:     "https://example.com/%zz",
+			ref:      "/path",
+			expected: "invalid base URL",
+		},
+		{
+			name:     "invalid ref",
+			base:     "https://example.com",
+			ref:      "http://[::1",
+			expected: "invalid ref URL",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := URLJoin[interface{}](constGetter(tt.base), constGetter(tt.ref))
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.ErrorContains(t, err, tt.expected)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// Weekday factory function returns the day of the week of the target time in the IANA location as an int64, from
+// 0 for Sunday to 6 for Saturday. An empty location is UTC. nil is returned for the zero time.
+func Weekday[K any](target ottl.Getter[K], location string) (ottl.ExprFunc[K], error) {
+	return timeComponent(target, location, func(t time.Time) int64 {
+		return int64(t.Weekday())
+	})
+}
+
+// timeComponent returns an ExprFunc that applies component to the target time in the IANA location, or returns
+// nil for the zero time.
+func timeComponent[K any](target ottl.Getter[K], location string, component func(time.Time) int64) (ottl.ExprFunc[K], error) {
+	loc, err := time.LoadLocation(location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid location %q: %w", location, err)
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		t, err := toTime(val)
+		if err != nil {
+			return nil, err
+		}
+		if t.IsZero() {
+			return nil, nil
+		}
+		return component(t.In(loc)), nil
+	}, nil
+}
@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_TimeComponents(t *testing.T) {
+	// In New York, daylight saving time started at 2023-03-12T07:00:00Z, when the clocks moved from 02:00 to 03:00.
+	beforeDST := time.Date(2023, 3, 12, 6, 30, 0, 0, time.UTC)
+	afterDST := time.Date(2023, 3, 12, 7, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		function func(ottl.Getter[interface{}], string) (ottl.ExprFunc[interface{}], error)
+		target   interface{}
+		location string
+		expected interface{}
+	}{
+		{
+			name:     "hour before DST in New York",
+			function: HourOfDay[interface{}],
+			target:   beforeDST,
+			location: "America/New_York",
+			expected: int64(1),
+		},
+		{
+			name:     "hour after DST in New York",
+			function: HourOfDay[interface{}],
+			target:   afterDST,
+			location: "America/New_York",
+			expected: int64(3),
+		},
+		{
+			name:     "hour before DST in UTC",
+			function: HourOfDay[interface{}],
+			target:   beforeDST,
+			location: "",
+			expected: int64(6),
+		},
+		{
+			name:     "hour after DST in UTC",
+			function: HourOfDay[interface{}],
+			target:   afterDST,
+			location: "",
+			expected: int64(7),
+		},
+		{
+			name:     "hour of unix nanoseconds in Tokyo",
+			function: HourOfDay[interface{}],
+			target:   afterDST.UnixNano(),
+			location: "Asia/Tokyo",
+			expected: int64(16),
+		},
+		{
+			name:     "weekday in UTC",
+			function: Weekday[interface{}],
+			target:   afterDST,
+			location: "",
+			expected: int64(time.Sunday),
+		},
+		{
+			name:     "weekday of previous day in Los Angeles",
+			function: Weekday[interface{}],
+			target:   afterDST,
+			location: "America/Los_Angeles",
+			expected: int64(time.Saturday),
+		},
+		{
+			name:     "weekday of timestamp in Tokyo",
+			function: Weekday[interface{}],
+			target:   pcommon.NewTimestampFromTime(time.Date(2023, 3, 11, 20, 0, 0, 0, time.UTC)),
+			location: "Asia/Tokyo",
+			expected: int64(time.Sunday),
+		},
+		{
+			name:     "hour of zero time",
+			function: HourOfDay[interface{}],
+			target:   int64(0),
+			location: "",
+			expected: nil,
+		},
+		{
+			name:     "weekday of zero time",
+			function: Weekday[interface{}],
+			target:   time.Time{},
+			location: "",
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := tt.function(constGetter(tt.target), tt.location)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_TimeComponents_Error(t *testing.T) {
+	exprFunc, err := Weekday[interface{}](constGetter("Sunday"), "")
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.EqualError(t, err, "target must be a time but got string")
+
+	_, err = HourOfDay[interface{}](constGetter(nil), "Mars/Olympus_Mons")
+	assert.ErrorContains(t, err, `invalid location "Mars/Olympus_Mons"`)
+}
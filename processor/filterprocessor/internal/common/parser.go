@@ -106,13 +106,97 @@ func statementsToExpr[K any](statements []*ottl.Statement[K]) expr.BoolExpr[K] {
 
 func functions[K any]() map[string]interface{} {
 	return map[string]interface{}{
-		"TraceID":     ottlfuncs.TraceID[K],
-		"SpanID":      ottlfuncs.SpanID[K],
-		"IsMatch":     ottlfuncs.IsMatch[K],
-		"Concat":      ottlfuncs.Concat[K],
-		"Split":       ottlfuncs.Split[K],
-		"Int":         ottlfuncs.Int[K],
-		"ConvertCase": ottlfuncs.ConvertCase[K],
+		"TraceID":               ottlfuncs.TraceID[K],
+		"SpanID":                ottlfuncs.SpanID[K],
+		"IsMatch":               ottlfuncs.IsMatch[K],
+		"Concat":                ottlfuncs.Concat[K],
+		"Split":                 ottlfuncs.Split[K],
+		"Int":                   ottlfuncs.Int[K],
+		"ConvertCase":           ottlfuncs.ConvertCase[K],
+		"Double":                ottlfuncs.Double[K],
+		"ParseInt":              ottlfuncs.ParseInt[K],
+		"String":                ottlfuncs.String[K],
+		"BuildURL":              ottlfuncs.BuildURL[K],
+		"IsBool":                ottlfuncs.IsBool[K],
+		"IsDouble":              ottlfuncs.IsDouble[K],
+		"IsList":                ottlfuncs.IsList[K],
+		"IsMap":                 ottlfuncs.IsMap[K],
+		"IsString":              ottlfuncs.IsString[K],
+		"IsValidJSON":           ottlfuncs.IsValidJSON[K],
+		"Contains":              ottlfuncs.Contains[K],
+		"HasKey":                ottlfuncs.HasKey[K],
+		"Sort":                  ottlfuncs.Sort[K],
+		"SliceIndex":            ottlfuncs.SliceIndex[K],
+		"Distinct":              ottlfuncs.Distinct[K],
+		"ParseTime":             ottlfuncs.ParseTime[K],
+		"FormatTime":            ottlfuncs.FormatTime[K],
+		"UnixMilli":             ottlfuncs.UnixMilli[K],
+		"UnixNano":              ottlfuncs.UnixNano[K],
+		"UnixSeconds":           ottlfuncs.UnixSeconds[K],
+		"TruncateTime":          ottlfuncs.TruncateTime[K],
+		"GetXML":                ottlfuncs.GetXML[K],
+		"GetJSONField":          ottlfuncs.GetJSONField[K],
+		"ParseURL":              ottlfuncs.ParseURL[K],
+		"ParseUserAgent":        ottlfuncs.ParseUserAgent[K],
+		"IsIPInRange":           ottlfuncs.IsIPInRange[K],
+		"Reverse":               ottlfuncs.Reverse[K],
+		"PadLeft":               ottlfuncs.PadLeft[K],
+		"PadRight":              ottlfuncs.PadRight[K],
+		"HashBucket":            ottlfuncs.HashBucket[K],
+		"TruncateIP":            ottlfuncs.TruncateIP[K],
+		"Round":                 ottlfuncs.Round[K],
+		"Add":                   ottlfuncs.Add[K],
+		"Subtract":              ottlfuncs.Subtract[K],
+		"Multiply":              ottlfuncs.Multiply[K],
+		"Divide":                ottlfuncs.Divide[K],
+		"Min":                   ottlfuncs.Min[K],
+		"Max":                   ottlfuncs.Max[K],
+		"Abs":                   ottlfuncs.Abs[K],
+		"Coalesce":              ottlfuncs.Coalesce[K],
+		"ConvertUnit":           ottlfuncs.ConvertUnit[K],
+		"CRC32":                 ottlfuncs.CRC32[K],
+		"FNV":                   ottlfuncs.FNV[K],
+		"ParseSyslog":           ottlfuncs.ParseSyslog[K],
+		"ParseStackTrace":       ottlfuncs.ParseStackTrace[K],
+		"ParseJSONSuffix":       ottlfuncs.ParseJSONSuffix[K],
+		"ParseJSONWithMaxDepth": ottlfuncs.ParseJSONWithMaxDepth[K],
+		"JSONEscape":            ottlfuncs.JSONEscape[K],
+		"JSONUnescape":          ottlfuncs.JSONUnescape[K],
+		"EditDistance":          ottlfuncs.EditDistance[K],
+		"Similarity":            ottlfuncs.Similarity[K],
+		"Line":                  ottlfuncs.Line[K],
+		"CountMatches":          ottlfuncs.CountMatches[K],
+		"ReplaceCaptureGroups":  ottlfuncs.ReplaceCaptureGroups[K],
+		"NormalizeKeys":         ottlfuncs.NormalizeKeys[K],
+		"FlattenSlice":          ottlfuncs.FlattenSlice[K],
+		"Select":                ottlfuncs.Select[K],
+		"Omit":                  ottlfuncs.Omit[K],
+		"IsEmpty":               ottlfuncs.IsEmpty[K],
+		"TraceContext":          ottlfuncs.TraceContext[K],
+		"RandInt":               ottlfuncs.RandInt[K],
+		"Ratio":                 ottlfuncs.Ratio[K],
+		"ParseJSONField":        ottlfuncs.ParseJSONField[K],
+		"Map":                   ottlfuncs.Map[K],
+		"MapWithDefault":        ottlfuncs.MapWithDefault[K],
+		"Elapsed":               ottlfuncs.Elapsed[K],
+		"HourOfDay":             ottlfuncs.HourOfDay[K],
+		"Weekday":               ottlfuncs.Weekday[K],
+		"SanitizeName":          ottlfuncs.SanitizeName[K],
+		"URLJoin":               ottlfuncs.URLJoin[K],
+		"RegistrableDomain":     ottlfuncs.RegistrableDomain[K],
+		"ParseISODuration":      ottlfuncs.ParseISODuration[K],
+		"MakeSlice":             ottlfuncs.MakeSlice[K],
+		"MakeMap":               ottlfuncs.MakeMap[K],
+		"ParseFloatList":        ottlfuncs.ParseFloatList[K],
+		"Average":               ottlfuncs.Average[K],
+		"SliceMax":              ottlfuncs.SliceMax[K],
+		"SliceMin":              ottlfuncs.SliceMin[K],
+		"Sum":                   ottlfuncs.Sum[K],
+		"ParseForm":             ottlfuncs.ParseForm[K],
+		"ParseNumber":           ottlfuncs.ParseNumber[K],
+		"TypeOf":                ottlfuncs.TypeOf[K],
+		"Scale":                 ottlfuncs.Scale[K],
+		"ParseWindowsEventXML":  ottlfuncs.ParseWindowsEventXML[K],
 		"drop": func() (ottl.ExprFunc[K], error) {
 			return func(context.Context, K) (interface{}, error) {
 				return true, nil